@@ -1,6 +1,10 @@
 package gokeycloak
 
-import "context"
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
 
 func (g *GoKeycloak) getAttackDetectionURL(realm string, user string, path ...string) string {
 	path = append([]string{g.basePath, g.Config.authAdminRealms, realm, g.Config.attackDetection, user}, path...)
@@ -9,6 +13,11 @@ func (g *GoKeycloak) getAttackDetectionURL(realm string, user string, path ...st
 
 // GetUserBruteForceDetectionStatus fetches a user status regarding brute force protection
 func (g *GoKeycloak) GetUserBruteForceDetectionStatus(ctx context.Context, accessToken, realm, userID string) (*BruteForceStatus, error) {
+	_, result, err := g.getUserBruteForceDetectionStatus(ctx, accessToken, realm, userID)
+	return result, err
+}
+
+func (g *GoKeycloak) getUserBruteForceDetectionStatus(ctx context.Context, accessToken, realm, userID string) (int, *BruteForceStatus, error) {
 	const errMessage = "could not brute force detection Status"
 	var result BruteForceStatus
 
@@ -17,8 +26,71 @@ func (g *GoKeycloak) GetUserBruteForceDetectionStatus(ctx context.Context, acces
 		Get(g.getAttackDetectionURL(realm, "users", userID))
 
 	if err := checkForError(resp, err, errMessage); err != nil {
-		return nil, err
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// ClearUserLoginFailures clears the login failures of a user and so unlocks a user locked out by brute force detection
+func (g *GoKeycloak) ClearUserLoginFailures(ctx context.Context, accessToken, realm, userID string) (int, error) {
+	const errMessage = "could not clear user login failures"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, accessToken).
+		Delete(g.getAttackDetectionURL(realm, "users", userID))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// ClearAllLoginFailures clears the login failures of all users of the realm and so unlocks all temporarily locked out users
+func (g *GoKeycloak) ClearAllLoginFailures(ctx context.Context, accessToken, realm string) (int, error) {
+	const errMessage = "could not clear login failures"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, accessToken).
+		Delete(g.getAttackDetectionURL(realm, "users"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetLockedUsers pages through the users of the realm and returns the users currently locked out by brute force detection.
+// params filter the users and params.Max is used as page size, see GetUsersPager. Keycloak can not list the locked users,
+// so it costs one request per page and one request per user. At most maxPages pages are checked, all if maxPages is 0,
+// the next pages can be checked by advancing params.First.
+// The status code is the one of the last request.
+func (g *GoKeycloak) GetLockedUsers(ctx context.Context, accessToken, realm string, params GetUsersParams, maxPages int) (int, []*LockedUser, error) {
+	var status int
+	pageSize := PInt(params.Max)
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	pager := NewPager(func(ctx context.Context, first, max int) ([]*User, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		var users []*User
+		var err error
+		status, users, err = g.GetUsers(ctx, accessToken, realm, params)
+		return users, err
+	}, PInt(params.First), pageSize)
+
+	result := []*LockedUser{}
+	for pages := 0; maxPages <= 0 || pages < maxPages; pages++ {
+		users, err := pager.Next(ctx)
+		if errors.Is(err, ErrPagerDone) {
+			break
+		}
+		if err != nil {
+			return status, nil, err
+		}
+		for _, user := range users {
+			var bruteForceStatus *BruteForceStatus
+			status, bruteForceStatus, err = g.getUserBruteForceDetectionStatus(ctx, accessToken, realm, PString(user.ID))
+			if err != nil {
+				return status, nil, err
+			}
+			if PBool(bruteForceStatus.Disabled) {
+				result = append(result, &LockedUser{User: user, Status: bruteForceStatus})
+			}
+		}
 	}
 
-	return &result, nil
+	return status, result, nil
 }
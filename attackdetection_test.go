@@ -98,6 +98,86 @@ func Test_GetUserBruteForceDetectionStatus(t *testing.T) {
 
 }
 
+func Test_ClearUserLoginFailures(t *testing.T) {
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+	_, realm, err := client.GetRealm(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "GetRealm failed")
+	settings := realm.BruteForceSettings()
+
+	realm.SetBruteForceSettings(gokeycloak.BruteForceSettings{
+		BruteForceProtected:   gokeycloak.BoolP(true),
+		FailureFactor:         gokeycloak.IntP(1),
+		MaxFailureWaitSeconds: gokeycloak.IntP(60),
+	})
+	_, err = client.UpdateRealm(
+		context.Background(),
+		token.AccessToken,
+		*realm)
+	require.NoError(t, err, "UpdateRealm failed")
+	defer func() {
+		realm.SetBruteForceSettings(settings)
+		_, err := client.UpdateRealm(
+			context.Background(),
+			token.AccessToken,
+			*realm)
+		require.NoError(t, err, "UpdateRealm failed")
+	}()
+
+	tearDownUser, userID := CreateUser(t, client)
+	defer tearDownUser()
+	_, fetchedUser, err := client.GetUserByID(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		userID)
+	require.NoError(t, err, "GetUserById failed")
+
+	_, _, err = client.Login(context.Background(),
+		cfg.GoKeycloak.ClientID,
+		cfg.GoKeycloak.ClientSecret,
+		cfg.GoKeycloak.Realm,
+		*fetchedUser.Username,
+		"wrong password")
+	require.Error(t, err, "Login should fail")
+
+	_, lockedUsers, err := client.GetLockedUsers(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		gokeycloak.GetUsersParams{Username: fetchedUser.Username},
+		1)
+	require.NoError(t, err, "GetLockedUsers failed")
+	require.Len(t, lockedUsers, 1)
+	require.Equal(t, userID, *lockedUsers[0].User.ID)
+	require.Equal(t, 1, *lockedUsers[0].Status.NumFailures)
+
+	_, err = client.ClearUserLoginFailures(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		userID)
+	require.NoError(t, err, "ClearUserLoginFailures failed")
+
+	bruteForceStatus, err := client.GetUserBruteForceDetectionStatus(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		userID)
+	require.NoError(t, err, "Getting attack status failed")
+	require.Equal(t, false, *bruteForceStatus.Disabled, "The user shouldn't be locked")
+
+	_, err = client.ClearAllLoginFailures(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "ClearAllLoginFailures failed")
+}
+
 func GetConfig(t testing.TB) *Config {
 	configOnce.Do(func() {
 		rand.NewSource(time.Now().UTC().UnixNano())
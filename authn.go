@@ -4,8 +4,10 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
 	"github.com/segmentio/ksuid"
 	"github.com/zblocks/gokeycloak/pkg/jwx"
 )
@@ -32,6 +34,10 @@ func (g *GoKeycloak) LoginClient(ctx context.Context, clientID, clientSecret, re
 // LoginClientTokenExchange will exchange the presented token for a user's token
 // Requires Token-Exchange is enabled: https://www.keycloak.org/docs/latest/securing_apps/index.html#_token-exchange
 func (g *GoKeycloak) LoginClientTokenExchange(ctx context.Context, clientID, token, clientSecret, realm, targetClient, userID string) (int, *JWT, error) {
+	return g.GetToken(ctx, realm, tokenExchangeOptions(clientID, token, clientSecret, targetClient, userID))
+}
+
+func tokenExchangeOptions(clientID, token, clientSecret, targetClient, userID string) TokenOptions {
 	tokenOptions := TokenOptions{
 		ClientID:           &clientID,
 		ClientSecret:       &clientSecret,
@@ -43,7 +49,53 @@ func (g *GoKeycloak) LoginClientTokenExchange(ctx context.Context, clientID, tok
 	if userID != "" {
 		tokenOptions.RequestedSubject = &userID
 	}
-	return g.GetToken(ctx, realm, tokenOptions)
+	return tokenOptions
+}
+
+// ImpersonateUserWithTokenExchange exchanges the token of the impersonating user for an access token of the requested user.
+// Requires Token-Exchange and a client which may impersonate users, see LoginClientTokenExchange.
+// The returned audit record names the impersonator, taken from the unverified subject token, the user and the reason.
+// If the client may not impersonate the user, the error is an APIError of type APIErrTypeImpersonationForbidden.
+func (g *GoKeycloak) ImpersonateUserWithTokenExchange(ctx context.Context, realm string, options ImpersonationOptions) (int, *ImpersonationToken, error) {
+	const errMessage = "could not impersonate user"
+
+	if options.RequestedSubject == "" {
+		return http.StatusBadRequest, nil, errors.Errorf("%s: a requested subject is required", errMessage)
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(options.SubjectToken, claims); err != nil {
+		return http.StatusBadRequest, nil, errors.Wrap(err, errMessage)
+	}
+
+	audience := options.Audience
+	if audience == "" {
+		audience = options.ClientID
+	}
+	tokenOptions := tokenExchangeOptions(options.ClientID, options.SubjectToken, options.ClientSecret, audience, options.RequestedSubject)
+	tokenOptions.RequestedTokenType = StringP("urn:ietf:params:oauth:token-type:access_token")
+	if options.Scope != "" {
+		tokenOptions.Scope = &options.Scope
+	}
+
+	status, token, err := g.GetToken(ctx, realm, tokenOptions)
+	if err != nil {
+		return status, nil, impersonationError(err)
+	}
+
+	impersonator, _ := claims["sub"].(string)
+	username, _ := claims["preferred_username"].(string)
+	return status, &ImpersonationToken{
+		Token: token,
+		Audit: ImpersonationAudit{
+			Impersonator:         impersonator,
+			ImpersonatorUsername: username,
+			Subject:              options.RequestedSubject,
+			ClientID:             options.ClientID,
+			Audience:             audience,
+			Reason:               options.Reason,
+			Time:                 time.Now(),
+		},
+	}, nil
 }
 
 // LoginClientSignedJWT performs a login with client credentials and signed jwt claims
@@ -213,6 +265,127 @@ func (g *GoKeycloak) CreateAuthenticationExecutionFlow(ctx context.Context, toke
 	return resp.StatusCode(), checkForError(resp, err, errMessage)
 }
 
+// CopyAuthenticationFlow copies the flow with the given alias and its executions to a new flow named newName
+func (g *GoKeycloak) CopyAuthenticationFlow(ctx context.Context, token, realm, flow, newName string) (int, error) {
+	const errMessage = "could not copy authentication flow"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(map[string]string{"newName": newName}).
+		Post(g.getAdminRealmURL(realm, "authentication", "flows", flow, "copy"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// RaiseAuthenticationExecutionPriority moves an execution one position up within its flow
+func (g *GoKeycloak) RaiseAuthenticationExecutionPriority(ctx context.Context, token, realm, executionID string) (int, error) {
+	const errMessage = "could not raise authentication execution priority"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Post(g.getAdminRealmURL(realm, "authentication", "executions", executionID, "raise-priority"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// LowerAuthenticationExecutionPriority moves an execution one position down within its flow
+func (g *GoKeycloak) LowerAuthenticationExecutionPriority(ctx context.Context, token, realm, executionID string) (int, error) {
+	const errMessage = "could not lower authentication execution priority"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Post(g.getAdminRealmURL(realm, "authentication", "executions", executionID, "lower-priority"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// CreateAuthenticatorConfig creates the configuration of an execution and returns its id
+func (g *GoKeycloak) CreateAuthenticatorConfig(ctx context.Context, token, realm, executionID string, config AuthenticatorConfigRepresentation) (int, string, error) {
+	const errMessage = "could not create authenticator config"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(config).
+		Post(g.getAdminRealmURL(realm, "authentication", "executions", executionID, "config"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), "", err
+	}
+	return resp.StatusCode(), getID(resp), nil
+}
+
+// GetAuthenticatorConfig returns the authenticator configuration with the given id
+func (g *GoKeycloak) GetAuthenticatorConfig(ctx context.Context, token, realm, configID string) (int, *AuthenticatorConfigRepresentation, error) {
+	const errMessage = "could not get authenticator config"
+	var result AuthenticatorConfigRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "authentication", "config", configID))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+	return resp.StatusCode(), &result, nil
+}
+
+// UpdateAuthenticatorConfig updates the authenticator configuration identified by config.ID
+func (g *GoKeycloak) UpdateAuthenticatorConfig(ctx context.Context, token, realm string, config AuthenticatorConfigRepresentation) (int, error) {
+	const errMessage = "could not update authenticator config"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(config).
+		Put(g.getAdminRealmURL(realm, "authentication", "config", PString(config.ID)))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// DeleteAuthenticatorConfig deletes the authenticator configuration with the given id
+func (g *GoKeycloak) DeleteAuthenticatorConfig(ctx context.Context, token, realm, configID string) (int, error) {
+	const errMessage = "could not delete authenticator config"
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "authentication", "config", configID))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetAuthenticatorConfigDescription describes the configuration properties of an authenticator provider
+func (g *GoKeycloak) GetAuthenticatorConfigDescription(ctx context.Context, token, realm, providerID string) (int, *AuthenticatorConfigInfoRepresentation, error) {
+	const errMessage = "could not get authenticator config description"
+	var result AuthenticatorConfigInfoRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "authentication", "config-description", providerID))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+	return resp.StatusCode(), &result, nil
+}
+
+// GetAuthenticatorProviders lists the authenticator providers which can be added to a flow
+func (g *GoKeycloak) GetAuthenticatorProviders(ctx context.Context, token, realm string) (int, []*AuthenticationProviderRepresentation, error) {
+	return g.getAuthenticationProviders(ctx, token, realm, "authenticator-providers")
+}
+
+// GetFormProviders lists the form providers which can be added to a form flow
+func (g *GoKeycloak) GetFormProviders(ctx context.Context, token, realm string) (int, []*AuthenticationProviderRepresentation, error) {
+	return g.getAuthenticationProviders(ctx, token, realm, "form-providers")
+}
+
+// GetFormActionProviders lists the form action providers which can be added to a form flow
+func (g *GoKeycloak) GetFormActionProviders(ctx context.Context, token, realm string) (int, []*AuthenticationProviderRepresentation, error) {
+	return g.getAuthenticationProviders(ctx, token, realm, "form-action-providers")
+}
+
+// GetClientAuthenticatorProviders lists the client authenticator providers which can be added to a client flow
+func (g *GoKeycloak) GetClientAuthenticatorProviders(ctx context.Context, token, realm string) (int, []*AuthenticationProviderRepresentation, error) {
+	return g.getAuthenticationProviders(ctx, token, realm, "client-authenticator-providers")
+}
+
+func (g *GoKeycloak) getAuthenticationProviders(ctx context.Context, token, realm, kind string) (int, []*AuthenticationProviderRepresentation, error) {
+	const errMessage = "could not get authentication providers"
+	var result []*AuthenticationProviderRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "authentication", kind))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+	return resp.StatusCode(), result, nil
+}
+
 // ------------------
 // Identity Providers
 // ------------------
@@ -0,0 +1,260 @@
+package gokeycloak
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCircuitOpen is returned for every call made while the circuit breaker is open.
+// Use errors.Is to check for it, the returned error is usually wrapped in an *APIError.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a CircuitBreaker
+type CircuitState int
+
+// CircuitState values
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+// String returns a string representation of the circuit state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerConfig holds the thresholds of a CircuitBreaker.
+// Zero values are replaced by the defaults documented on each field.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures which opens the circuit (default 5)
+	FailureThreshold int
+	// OpenTimeout is the time the circuit stays open before probes are let through (default 30s)
+	OpenTimeout time.Duration
+	// HalfOpenProbes is the number of concurrent probe requests allowed while half-open (default 1)
+	HalfOpenProbes int
+	// SuccessThreshold is the number of successful probes needed to close the circuit again (default 1)
+	SuccessThreshold int
+	// IsFailure decides whether a round trip counts as a failure.
+	// Defaults to transport errors and 5xx responses.
+	IsFailure func(resp *http.Response, err error) bool
+	// OnStateChange is called on every state transition. It must not block.
+	OnStateChange func(from, to CircuitState)
+}
+
+// CircuitBreaker guards the requests sent to Keycloak.
+// Once FailureThreshold consecutive requests failed, all requests fail immediately with ErrCircuitOpen
+// until OpenTimeout elapsed. Afterwards up to HalfOpenProbes requests are let through to check whether
+// Keycloak recovered.
+type CircuitBreaker struct {
+	config CircuitBreakerConfig
+	now    func() time.Time
+
+	// transportMu serializes guardTransport
+	transportMu sync.Mutex
+
+	mu          sync.Mutex
+	state       CircuitState
+	failures    int
+	successes   int
+	probes      int
+	openedAt    time.Time
+	transitions []func()
+}
+
+// NewCircuitBreaker creates a new CircuitBreaker
+func NewCircuitBreaker(config CircuitBreakerConfig) *CircuitBreaker {
+	if config.FailureThreshold <= 0 {
+		config.FailureThreshold = 5
+	}
+	if config.OpenTimeout <= 0 {
+		config.OpenTimeout = 30 * time.Second
+	}
+	if config.HalfOpenProbes <= 0 {
+		config.HalfOpenProbes = 1
+	}
+	if config.SuccessThreshold <= 0 {
+		config.SuccessThreshold = 1
+	}
+	if config.IsFailure == nil {
+		config.IsFailure = isCircuitFailure
+	}
+
+	return &CircuitBreaker{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+func isCircuitFailure(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return resp != nil && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// State returns the current state of the circuit
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mu.Lock()
+	cb.refresh()
+	state := cb.state
+	cb.flush()
+
+	return state
+}
+
+// Reset closes the circuit and clears all counters
+func (cb *CircuitBreaker) Reset() {
+	cb.mu.Lock()
+	cb.setState(CircuitClosed)
+	cb.flush()
+}
+
+// allow reserves a slot for a request or returns ErrCircuitOpen.
+func (cb *CircuitBreaker) allow() error {
+	cb.mu.Lock()
+	defer cb.flush()
+
+	cb.refresh()
+	switch cb.state {
+	case CircuitOpen:
+		return ErrCircuitOpen
+	case CircuitHalfOpen:
+		if cb.probes >= cb.config.HalfOpenProbes {
+			return ErrCircuitOpen
+		}
+		cb.probes++
+	}
+
+	return nil
+}
+
+// done records the outcome of a request which passed allow.
+func (cb *CircuitBreaker) done(failed bool) {
+	cb.mu.Lock()
+	defer cb.flush()
+
+	switch cb.state {
+	case CircuitClosed:
+		if !failed {
+			cb.failures = 0
+			return
+		}
+		cb.failures++
+		if cb.failures >= cb.config.FailureThreshold {
+			cb.setState(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		if cb.probes > 0 {
+			cb.probes--
+		}
+		if failed {
+			cb.setState(CircuitOpen)
+			return
+		}
+		cb.successes++
+		if cb.successes >= cb.config.SuccessThreshold {
+			cb.setState(CircuitClosed)
+		}
+	}
+}
+
+// refresh moves an open circuit to half-open once the open timeout elapsed. Requires cb.mu.
+func (cb *CircuitBreaker) refresh() {
+	if cb.state == CircuitOpen && cb.now().Sub(cb.openedAt) >= cb.config.OpenTimeout {
+		cb.setState(CircuitHalfOpen)
+	}
+}
+
+// setState changes the state and queues the callback. Requires cb.mu.
+func (cb *CircuitBreaker) setState(state CircuitState) {
+	from := cb.state
+	cb.state = state
+	cb.failures = 0
+	cb.successes = 0
+	cb.probes = 0
+	if state == CircuitOpen {
+		cb.openedAt = cb.now()
+	}
+	if from != state && cb.config.OnStateChange != nil {
+		cb.transitions = append(cb.transitions, func() { cb.config.OnStateChange(from, state) })
+	}
+}
+
+// flush releases cb.mu and runs the queued state change callbacks outside of the lock.
+func (cb *CircuitBreaker) flush() {
+	transitions := cb.transitions
+	cb.transitions = nil
+	cb.mu.Unlock()
+
+	for _, transition := range transitions {
+		transition()
+	}
+}
+
+// RoundTripper wraps the given transport with the circuit breaker
+func (cb *CircuitBreaker) RoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &circuitTransport{breaker: cb, next: next}
+}
+
+type circuitTransport struct {
+	breaker *CircuitBreaker
+	next    http.RoundTripper
+}
+
+func (t *circuitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.breaker.allow(); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	t.breaker.done(t.breaker.config.IsFailure(resp, err))
+
+	return resp, err
+}
+
+// SetCircuitBreaker guards all requests of the client with a circuit breaker.
+// While the circuit is open DecodeAccessToken keeps working with the last known certificates of a realm.
+// The breaker wraps the transport of the resty client. It is wrapped again before each request,
+// so the breaker also guards a resty client or a transport set later on.
+func SetCircuitBreaker(config CircuitBreakerConfig) func(g *GoKeycloak) {
+	return func(g *GoKeycloak) {
+		g.circuitBreaker = NewCircuitBreaker(config)
+		g.guardTransport()
+	}
+}
+
+// guardTransport wraps the transport of the resty client with the circuit breaker, unless it is wrapped already
+func (g *GoKeycloak) guardTransport() {
+	if g.circuitBreaker == nil {
+		return
+	}
+	g.circuitBreaker.transportMu.Lock()
+	defer g.circuitBreaker.transportMu.Unlock()
+
+	httpClient := g.restyClient.GetClient()
+	if transport, ok := httpClient.Transport.(*circuitTransport); ok && transport.breaker == g.circuitBreaker {
+		return
+	}
+	g.restyClient.SetTransport(g.circuitBreaker.RoundTripper(httpClient.Transport))
+}
+
+// CircuitBreaker returns the circuit breaker of the client or nil, if none is configured
+func (g *GoKeycloak) CircuitBreaker() *CircuitBreaker {
+	return g.circuitBreaker
+}
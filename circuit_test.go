@@ -0,0 +1,165 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_CircuitBreaker_OpensAndRecovers(t *testing.T) {
+	t.Parallel()
+
+	var failing atomic.Bool
+	failing.Store(true)
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"realm":"test"}`))
+	})
+
+	var mu sync.Mutex
+	var transitions []string
+	client := gokeycloak.NewClient(server.URL, gokeycloak.SetCircuitBreaker(gokeycloak.CircuitBreakerConfig{
+		FailureThreshold: 2,
+		OpenTimeout:      50 * time.Millisecond,
+		OnStateChange: func(from, to gokeycloak.CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, from.String()+"->"+to.String())
+		},
+	}))
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.GetRealm(ctx, "token", "test")
+		require.Error(t, err)
+		require.False(t, errors.Is(err, gokeycloak.ErrCircuitOpen))
+	}
+	require.Equal(t, gokeycloak.CircuitOpen, client.CircuitBreaker().State())
+
+	_, _, err := client.GetRealm(ctx, "token", "test")
+	require.ErrorIs(t, err, gokeycloak.ErrCircuitOpen)
+	apiErr, ok := err.(*gokeycloak.APIError)
+	require.True(t, ok, "expected an *APIError")
+	require.Equal(t, 0, apiErr.Code)
+
+	failing.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	require.Equal(t, gokeycloak.CircuitHalfOpen, client.CircuitBreaker().State())
+
+	_, realm, err := client.GetRealm(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", gokeycloak.PString(realm.Realm))
+	require.Equal(t, gokeycloak.CircuitClosed, client.CircuitBreaker().State())
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"closed->open", "open->half-open", "half-open->closed"}, transitions)
+}
+
+func Test_CircuitBreaker_DecodeAccessTokenWithLastKnownCerts(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	certs := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kid": "test-key",
+			"kty": "RSA",
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	}
+
+	var down atomic.Bool
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(certs)
+	})
+
+	client := gokeycloak.NewClient(
+		server.URL,
+		gokeycloak.SetCertCacheInvalidationTime(time.Millisecond),
+		gokeycloak.SetCircuitBreaker(gokeycloak.CircuitBreakerConfig{
+			FailureThreshold: 1,
+			OpenTimeout:      time.Hour,
+		}),
+	)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"sub": "user",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = "test-key"
+	accessToken, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, _, claims, err := client.DecodeAccessToken(ctx, accessToken, "test")
+	require.NoError(t, err)
+	require.Equal(t, "user", (*claims)["sub"])
+
+	down.Store(true)
+	time.Sleep(10 * time.Millisecond)
+
+	_, _, err = client.GetRealm(ctx, "token", "test")
+	require.Error(t, err)
+	require.Equal(t, gokeycloak.CircuitOpen, client.CircuitBreaker().State())
+
+	_, _, claims, err = client.DecodeAccessToken(ctx, accessToken, "test")
+	require.NoError(t, err, "DecodeAccessToken must use the last known certs while the circuit is open")
+	require.Equal(t, "user", (*claims)["sub"])
+
+	_, _, err = client.GetRealm(ctx, "token", "test")
+	require.ErrorIs(t, err, gokeycloak.ErrCircuitOpen)
+}
+
+func Test_CircuitBreaker_SurvivesRestyClientAndTransportChanges(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	client := gokeycloak.NewClient(server.URL, gokeycloak.SetCircuitBreaker(gokeycloak.CircuitBreakerConfig{
+		FailureThreshold: 1,
+		OpenTimeout:      time.Hour,
+	}))
+	// both replace the transport wrapped by the circuit breaker
+	client.SetRestyClient(resty.New())
+	client.RestyClient().SetTransport(&http.Transport{})
+	ctx := context.Background()
+
+	_, _, err := client.GetCerts(ctx, "test")
+	require.Error(t, err)
+	require.False(t, errors.Is(err, gokeycloak.ErrCircuitOpen))
+	require.Equal(t, gokeycloak.CircuitOpen, client.CircuitBreaker().State())
+
+	_, _, err = client.GetCerts(ctx, "test")
+	require.ErrorIs(t, err, gokeycloak.ErrCircuitOpen)
+	require.Len(t, server.recorded(), 1)
+}
@@ -16,11 +16,15 @@ import (
 
 // GoCloak provides functionalities to talk to Keycloak.
 type GoKeycloak struct {
-	basePath    string
-	certsCache  sync.Map
-	certsLock   sync.Mutex
-	restyClient *resty.Client
-	Config      struct {
+	basePath       string
+	certs          *certsCache
+	restyClient    *resty.Client
+	preparedClient *resty.Client
+	options        *requestOptions
+	circuitBreaker *CircuitBreaker
+	beforeHooks    []BeforeCallHook
+	afterHooks     []AfterCallHook
+	Config         struct {
 		CertsInvalidateTime time.Duration
 		authAdminRealms     string
 		authRealms          string
@@ -29,6 +33,13 @@ type GoKeycloak struct {
 	}
 }
 
+// certsCache holds the certificates of the realms, it is shared by the copies made by WithOptions
+type certsCache struct {
+	cache     sync.Map
+	lastKnown sync.Map
+	lock      sync.Mutex
+}
+
 const (
 	adminClientID string = "admin-cli"
 	urlSeparator  string = "/"
@@ -42,8 +53,7 @@ func makeURL(path ...string) string {
 func (g *GoKeycloak) GetRequest(ctx context.Context) *resty.Request {
 	var err HTTPErrorResponse
 	return injectTracingHeaders(
-		ctx, g.restyClient.R().
-			SetContext(ctx).
+		ctx, g.newRequest(ctx).
 			SetError(&err),
 	)
 }
@@ -131,8 +141,8 @@ func injectTracingHeaders(ctx context.Context, req *resty.Request) *resty.Reques
 // NewClient creates a new Client
 func NewClient(basePath string, options ...func(*GoKeycloak)) *GoKeycloak {
 	c := GoKeycloak{
-		basePath:    strings.TrimRight(basePath, urlSeparator),
-		restyClient: resty.New(),
+		basePath: strings.TrimRight(basePath, urlSeparator),
+		certs:    &certsCache{},
 	}
 
 	c.Config.CertsInvalidateTime = 10 * time.Minute
@@ -140,6 +150,7 @@ func NewClient(basePath string, options ...func(*GoKeycloak)) *GoKeycloak {
 	c.Config.authRealms = makeURL("realms")
 	c.Config.openIDConnect = makeURL("protocol", "openid-connect")
 	c.Config.attackDetection = makeURL("attack-detection", "brute-force")
+	c.SetRestyClient(resty.New())
 
 	for _, option := range options {
 		option(&c)
@@ -155,8 +166,17 @@ func (g *GoKeycloak) RestyClient() *resty.Client {
 }
 
 // SetRestyClient overwrites the internal resty g.
+// The client gets the middlewares which apply the request options, they are added once.
+// The circuit breaker of SetCircuitBreaker wraps the transport of the client.
 func (g *GoKeycloak) SetRestyClient(restyClient *resty.Client) {
 	g.restyClient = restyClient
+	if restyClient != g.preparedClient {
+		restyClient.OnBeforeRequest(prepareRequest)
+		restyClient.OnAfterResponse(releaseResponse)
+		restyClient.OnError(releaseRequest)
+		g.preparedClient = restyClient
+	}
+	g.guardTransport()
 }
 
 // ==== Functional Options ===
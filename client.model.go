@@ -1,5 +1,11 @@
 package gokeycloak
 
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
 type ClientInitialAccessTokenResponse struct {
 	ID             string `json:"id"`
 	Token          string `json:"token"`
@@ -32,4 +38,161 @@ type CreateClientResponse struct {
 	BackchannelLogoutSessionRequired      bool     `json:"backchannel_logout_session_required,omitempty"`
 	RequirePushedAuthorizationRequests    bool     `json:"require_pushed_authorization_requests,omitempty"`
 	FrontchannelLogoutSessionRequired     bool     `json:"frontchannel_logout_session_required,omitempty"`
-}
\ No newline at end of file
+}
+
+// clientAdminNames maps the snake case json names of Client to the camel case names of the admin API
+var clientAdminNames = adminJSONNames(reflect.TypeOf(Client{}))
+
+// protocolMapperAdminNames maps the snake case json names of ProtocolMapperRepresentation to the camel case names of the admin API
+var protocolMapperAdminNames = adminJSONNames(reflect.TypeOf(ProtocolMapperRepresentation{}))
+
+// adminJSONNames returns the camel case names of all snake case json names of the struct type t
+func adminJSONNames(t reflect.Type) map[string]string {
+	names := map[string]string{}
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		parts := strings.Split(name, "_")
+		for j := 1; j < len(parts); j++ {
+			if parts[j] != "" {
+				parts[j] = strings.ToUpper(parts[j][:1]) + parts[j][1:]
+			}
+		}
+		if camel := strings.Join(parts, ""); camel != name {
+			names[name] = camel
+		}
+	}
+	return names
+}
+
+// renameJSONFields renames the top level fields of a JSON object. Fields which exist under their new name are kept.
+func renameJSONFields(fields map[string]json.RawMessage, names map[string]string) {
+	for from, to := range names {
+		value, ok := fields[from]
+		if !ok {
+			continue
+		}
+		delete(fields, from)
+		if _, ok := fields[to]; !ok {
+			fields[to] = value
+		}
+	}
+}
+
+// invertNames swaps the keys and values of names
+func invertNames(names map[string]string) map[string]string {
+	inverted := make(map[string]string, len(names))
+	for from, to := range names {
+		inverted[to] = from
+	}
+	return inverted
+}
+
+// adminClient decodes a client of the admin API or a realm export, which use camel case names unlike Client
+type adminClient Client
+
+// UnmarshalJSON decodes the client with camel case as well as snake case names
+func (c *adminClient) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	mappers := fields["protocolMappers"]
+	delete(fields, "protocolMappers")
+	renameJSONFields(fields, invertNames(clientAdminNames))
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, (*Client)(c)); err != nil {
+		return err
+	}
+	if mappers == nil {
+		return nil
+	}
+
+	var representations []adminProtocolMapper
+	if err := json.Unmarshal(mappers, &representations); err != nil {
+		return err
+	}
+	if representations == nil {
+		c.ProtocolMappers = nil
+		return nil
+	}
+	result := make([]ProtocolMapperRepresentation, 0, len(representations))
+	for _, mapper := range representations {
+		result = append(result, ProtocolMapperRepresentation(mapper))
+	}
+	c.ProtocolMappers = &result
+	return nil
+}
+
+// adminProtocolMapper decodes a protocol mapper of the admin API, which uses camel case names unlike ProtocolMapperRepresentation
+type adminProtocolMapper ProtocolMapperRepresentation
+
+// UnmarshalJSON decodes the protocol mapper with camel case as well as snake case names
+func (p *adminProtocolMapper) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	renameJSONFields(fields, invertNames(protocolMapperAdminNames))
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, (*ProtocolMapperRepresentation)(p))
+}
+
+// adminClients converts clients decoded as adminClient
+func adminClients(clients []adminClient) []Client {
+	result := make([]Client, 0, len(clients))
+	for _, client := range clients {
+		result = append(result, Client(client))
+	}
+	return result
+}
+
+// adminRepresentation encodes the client with the camel case names of the admin API
+func (c Client) adminRepresentation() (map[string]json.RawMessage, error) {
+	mappers := c.ProtocolMappers
+	c.ProtocolMappers = nil
+
+	fields, err := adminFields(c, clientAdminNames)
+	if err != nil {
+		return nil, err
+	}
+	if mappers != nil {
+		representations := make([]map[string]json.RawMessage, 0, len(*mappers))
+		for _, mapper := range *mappers {
+			representation, err := mapper.adminRepresentation()
+			if err != nil {
+				return nil, err
+			}
+			representations = append(representations, representation)
+		}
+		if fields["protocolMappers"], err = json.Marshal(representations); err != nil {
+			return nil, err
+		}
+	}
+
+	return fields, nil
+}
+
+// adminRepresentation encodes the protocol mapper with the camel case names of the admin API
+func (p ProtocolMapperRepresentation) adminRepresentation() (map[string]json.RawMessage, error) {
+	return adminFields(p, protocolMapperAdminNames)
+}
+
+func adminFields(v interface{}, names map[string]string) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	renameJSONFields(fields, names)
+
+	return fields, nil
+}
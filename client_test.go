@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/rsa"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
@@ -1943,6 +1944,89 @@ func Test_GetAdapterConfigurationForClientRepresentation(t *testing.T) {
 	require.Error(t, err, "Should fail because the deleted client doesn't exist anymore")
 }
 
+func Test_GetClientInstallationOIDCJSON(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+	testClient := GetClientByClientID(t, client, cfg.GoKeycloak.ClientID)
+
+	_, installation, err := client.GetClientInstallation(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		*testClient.ID,
+		gokeycloak.InstallationOIDCJSON,
+	)
+	require.NoError(t, err, "GetClientInstallation failed")
+	adapterConfig, err := installation.AdapterConfiguration()
+	require.NoError(t, err, "AdapterConfiguration failed")
+	require.Equal(t, cfg.GoKeycloak.Realm, gokeycloak.PString(adapterConfig.Realm))
+	require.Equal(t, cfg.GoKeycloak.ClientID, gokeycloak.PString(adapterConfig.Resource))
+}
+
+func Test_EvaluateClientScopesForUser(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	SetUpTestUser(t, client)
+	token := GetAdminToken(t, client)
+	testClient := GetClientByClientID(t, client, cfg.GoKeycloak.ClientID)
+
+	evaluation, err := client.EvaluateClientScopes(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		*testClient.ID,
+		testUserID,
+		"openid email",
+	)
+	require.NoError(t, err, "EvaluateClientScopes failed")
+	require.NotEmpty(t, evaluation.Mappers)
+	require.Equal(t, testUserID, evaluation.AccessToken.Subject)
+	require.Equal(t, cfg.GoKeycloak.UserName+"@localhost.com", evaluation.UserInfo.Email)
+	require.NotEmpty(t, evaluation.Explain())
+}
+
+func Test_UpdateGroupManagementPermissions(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, groupID := CreateGroup(t, client)
+	defer tearDown()
+
+	_, permissions, err := client.GetGroupManagementPermissions(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		groupID,
+	)
+	require.NoError(t, err, "GetGroupManagementPermissions failed")
+	require.False(t, gokeycloak.PBool(permissions.Enabled))
+
+	_, permissions, err = client.UpdateGroupManagementPermissions(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		groupID,
+		gokeycloak.ManagementPermissionReference{Enabled: gokeycloak.BoolP(true)},
+	)
+	require.NoError(t, err, "UpdateGroupManagementPermissions failed")
+	require.True(t, gokeycloak.PBool(permissions.Enabled))
+	require.Contains(t, *permissions.ScopePermissions, "manage-members")
+
+	_, _, err = client.UpdateGroupManagementPermissions(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		groupID,
+		gokeycloak.ManagementPermissionReference{Enabled: gokeycloak.BoolP(false)},
+	)
+	require.NoError(t, err, "UpdateGroupManagementPermissions failed")
+}
+
 func Test_GetGroups(t *testing.T) {
 	t.Parallel()
 	cfg := GetConfig(t)
@@ -2332,6 +2416,25 @@ func Test_GetRealm(t *testing.T) {
 	require.NoError(t, err, "GetRealm failed")
 }
 
+func Test_GetFullRealm(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	r, err := client.GetFullRealm(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "GetFullRealm failed")
+	require.NotNil(t, r.Clients)
+	require.NotNil(t, r.AuthenticationFlows)
+
+	diff, err := gokeycloak.DiffRealms(*r, *r, gokeycloak.DiffOptions{})
+	require.NoError(t, err, "DiffRealms failed")
+	require.True(t, diff.Empty(), diff.String())
+}
+
 func Test_GetRealms(t *testing.T) {
 	t.Parallel()
 	client := NewClientWithDebug(t)
@@ -2422,6 +2525,199 @@ func Test_ClearRealmCache(t *testing.T) {
 	ClearRealmCache(t, client)
 }
 
+func Test_PartialExportImportRealm(t *testing.T) {
+	t.Parallel()
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDownSource, source := CreateRealm(t, client)
+	defer tearDownSource()
+	tearDownTarget, target := CreateRealm(t, client)
+	defer tearDownTarget()
+
+	_, export, err := client.PartialExportRealm(
+		context.Background(),
+		token.AccessToken,
+		source,
+		gokeycloak.PartialExportParams{
+			ExportClients:        gokeycloak.BoolP(true),
+			ExportGroupsAndRoles: gokeycloak.BoolP(true),
+		})
+	require.NoError(t, err, "PartialExportRealm failed")
+	require.NotNil(t, export.Roles)
+
+	_, result, err := client.PartialImportRealm(
+		context.Background(),
+		token.AccessToken,
+		target,
+		gokeycloak.PartialImportRepresentation{
+			IfResourceExists: gokeycloak.PartialImportSkip,
+			Roles:            export.Roles,
+		})
+	require.NoError(t, err, "PartialImportRealm failed")
+	require.NotEmpty(t, result.Items(*gokeycloak.PartialImportAdded))
+	for _, item := range result.Items(*gokeycloak.PartialImportAdded) {
+		require.NotNil(t, item.ID)
+		require.NotNil(t, item.ResourceType)
+	}
+}
+
+func Test_AddRemoveClientPolicy(t *testing.T) {
+	t.Parallel()
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, realm := CreateRealm(t, client)
+	defer tearDown()
+
+	profile := GetRandomName("profile")
+	_, err := client.AddClientProfile(
+		context.Background(),
+		token.AccessToken,
+		realm,
+		gokeycloak.ClientProfileRepresentation{
+			Name: &profile,
+			Executors: &[]gokeycloak.ClientPolicyExecutorRepresentation{{
+				Executor:      gokeycloak.StringP("pkce-enforcer"),
+				Configuration: &map[string]interface{}{"auto-configure": "true"},
+			}},
+		})
+	require.NoError(t, err, "AddClientProfile failed")
+
+	policy := GetRandomName("policy")
+	_, err = client.AddClientPolicy(
+		context.Background(),
+		token.AccessToken,
+		realm,
+		gokeycloak.ClientPolicyDefinition{
+			Name:    &policy,
+			Enabled: gokeycloak.BoolP(true),
+			Conditions: &[]gokeycloak.ClientPolicyConditionRepresentation{{
+				Condition:     gokeycloak.StringP("client-access-type"),
+				Configuration: &map[string]interface{}{"type": []string{"public"}},
+			}},
+			Profiles: &[]string{profile},
+		})
+	require.NoError(t, err, "AddClientPolicy failed")
+
+	_, policies, err := client.GetClientPolicies(context.Background(), token.AccessToken, realm, true)
+	require.NoError(t, err, "GetClientPolicies failed")
+	require.Len(t, *policies.Policies, 1)
+	require.Equal(t, policy, *(*policies.Policies)[0].Name)
+	require.Equal(t, []string{profile}, *(*policies.Policies)[0].Profiles)
+
+	_, profiles, err := client.GetClientProfiles(context.Background(), token.AccessToken, realm, true)
+	require.NoError(t, err, "GetClientProfiles failed")
+	require.Len(t, *profiles.Profiles, 1)
+	require.NotEmpty(t, *profiles.GlobalProfiles)
+
+	_, err = client.RemoveClientPolicy(context.Background(), token.AccessToken, realm, policy)
+	require.NoError(t, err, "RemoveClientPolicy failed")
+	_, err = client.RemoveClientProfile(context.Background(), token.AccessToken, realm, profile)
+	require.NoError(t, err, "RemoveClientProfile failed")
+
+	_, policies, err = client.GetClientPolicies(context.Background(), token.AccessToken, realm, false)
+	require.NoError(t, err, "GetClientPolicies failed")
+	require.True(t, policies.Policies == nil || len(*policies.Policies) == 0, "the client policy must be removed")
+}
+
+func Test_UpdateGetDeleteRealmLocalizationText(t *testing.T) {
+	t.Parallel()
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, realm := CreateRealm(t, client)
+	defer tearDown()
+
+	_, err := client.UpdateRealmLocalizationText(
+		context.Background(),
+		token.AccessToken,
+		realm,
+		"de",
+		"doLogIn",
+		"Anmelden",
+	)
+	require.NoError(t, err, "UpdateRealmLocalizationText failed")
+	_, text, err := client.GetRealmLocalizationText(context.Background(), token.AccessToken, realm, "de", "doLogIn")
+	require.NoError(t, err, "GetRealmLocalizationText failed")
+	require.Equal(t, "Anmelden", text)
+
+	_, err = client.ImportRealmMessages(
+		context.Background(),
+		token.AccessToken,
+		realm,
+		"fr",
+		strings.NewReader("doLogIn=Connexion\nloginTitle=Connexion \u00e0 {0}\n"),
+	)
+	require.NoError(t, err, "ImportRealmMessages failed")
+	_, text, err = client.GetRealmLocalizationText(context.Background(), token.AccessToken, realm, "fr", "loginTitle")
+	require.NoError(t, err, "GetRealmLocalizationText failed")
+	require.Equal(t, "Connexion à {0}", text)
+
+	_, locales, err := client.GetRealmLocales(context.Background(), token.AccessToken, realm)
+	require.NoError(t, err, "GetRealmLocales failed")
+	require.ElementsMatch(t, []string{"de", "fr"}, locales)
+
+	_, err = client.DeleteRealmLocalizationText(context.Background(), token.AccessToken, realm, "de", "doLogIn")
+	require.NoError(t, err, "DeleteRealmLocalizationText failed")
+	_, _, err = client.GetRealmLocalizationText(context.Background(), token.AccessToken, realm, "de", "doLogIn")
+	require.Error(t, err)
+}
+
+func Test_GetUserProfileAndMetadata(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	_, profile, err := client.GetUserProfile(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "GetUserProfile failed")
+	require.NotEmpty(t, *profile.Attributes)
+
+	_, metadata, err := client.GetUserProfileMetadata(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "GetUserProfileMetadata failed")
+	require.NotEmpty(t, *metadata.Attributes)
+
+	err = client.ValidateUser(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		gokeycloak.User{
+			Username:  GetRandomNameP("username"),
+			Email:     gokeycloak.StringP("not an email"),
+			FirstName: gokeycloak.StringP("John"),
+			LastName:  gokeycloak.StringP("Doe"),
+		})
+	var validationErr *gokeycloak.UserProfileValidationError
+	require.True(t, errors.As(err, &validationErr), "ValidateUser must reject an invalid email")
+}
+
+func Test_PlanAndApplyRealm(t *testing.T) {
+	t.Parallel()
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, realm := CreateRealm(t, client)
+	defer tearDown()
+
+	clientID := GetRandomName("reconciled")
+	desired := gokeycloak.RealmRepresentation{
+		Realm:   &realm,
+		Clients: &[]gokeycloak.Client{{ClientID: &clientID, PublicClient: gokeycloak.BoolP(true)}},
+	}
+	plan, err := client.PlanRealm(context.Background(), token.AccessToken, desired, gokeycloak.ReconcileOptions{NoDelete: true})
+	require.NoError(t, err, "PlanRealm failed")
+	require.Len(t, plan.Steps, 1)
+	require.Equal(t, gokeycloak.ReconcileCreate, plan.Steps[0].Action)
+
+	err = client.ApplyRealmPlan(context.Background(), token.AccessToken, plan)
+	require.NoError(t, err, "ApplyRealmPlan failed")
+
+	plan, err = client.PlanRealm(context.Background(), token.AccessToken, desired, gokeycloak.ReconcileOptions{NoDelete: true})
+	require.NoError(t, err, "PlanRealm failed")
+	require.Empty(t, plan.Steps, "applying a plan must be idempotent")
+}
+
 // -----------
 // Realm Roles
 // -----------
@@ -3187,6 +3483,119 @@ func Test_GetUsersByClientRoleName(t *testing.T) {
 	require.Equal(t, userID, *users[0].ID)
 }
 
+func Test_ImpersonateUser(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, userID := CreateUser(t, client)
+	defer tearDown()
+
+	_, impersonation, err := client.ImpersonateUser(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		userID,
+	)
+	require.NoError(t, err, "ImpersonateUser failed")
+	require.NotEmpty(t, gokeycloak.PString(impersonation.Redirect))
+	require.NotEmpty(t, impersonation.Cookies)
+}
+
+func Test_CollectUsersWithPager(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	prefix := GetRandomName("paged")
+	for i := 0; i < 3; i++ {
+		_, userID, err := client.CreateUser(
+			context.Background(),
+			token.AccessToken,
+			cfg.GoKeycloak.Realm,
+			gokeycloak.User{
+				Username: gokeycloak.StringP(prefix + strconv.Itoa(i)),
+				Enabled:  gokeycloak.BoolP(true),
+			})
+		require.NoError(t, err, "CreateUser failed")
+		defer func() {
+			_, err := client.DeleteUser(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, userID)
+			require.NoError(t, err, "DeleteUser failed")
+		}()
+	}
+
+	pager := client.GetUsersPager(token.AccessToken, cfg.GoKeycloak.Realm, gokeycloak.GetUsersParams{
+		Search: &prefix,
+	}, 2)
+	users, err := gokeycloak.CollectAll(context.Background(), pager, 10)
+	require.NoError(t, err, "CollectAll failed")
+	require.Len(t, users, 3)
+}
+
+func Test_GetUserConsentsAndRevokeOfflineTokens(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	SetUpTestUser(t, client)
+	_, _, err := client.GetToken(
+		context.Background(),
+		cfg.GoKeycloak.Realm,
+		gokeycloak.TokenOptions{
+			ClientID:     &cfg.GoKeycloak.ClientID,
+			ClientSecret: &cfg.GoKeycloak.ClientSecret,
+			Username:     &cfg.GoKeycloak.UserName,
+			Password:     &cfg.GoKeycloak.Password,
+			GrantType:    gokeycloak.StringP("password"),
+			Scope:        gokeycloak.StringP("openid offline_access"),
+		},
+	)
+	require.NoError(t, err, "Login failed")
+	token := GetAdminToken(t, client)
+
+	_, consents, err := client.GetUserConsents(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		testUserID,
+	)
+	require.NoError(t, err, "GetUserConsents failed")
+	for _, consent := range consents {
+		require.NotNil(t, consent.ClientID)
+	}
+
+	revoked, err := client.RevokeUserOfflineTokens(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		testUserID,
+	)
+	require.NoError(t, err, "RevokeUserOfflineTokens failed")
+	require.Contains(t, revoked, cfg.GoKeycloak.ClientID)
+}
+
+func Test_GetLockedUsers(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+
+	tearDown, userID := CreateUser(t, client)
+	defer tearDown()
+	_, lockedUsers, err := client.GetLockedUsers(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		gokeycloak.GetUsersParams{},
+		10,
+	)
+	require.NoError(t, err, "GetLockedUsers failed")
+	for _, locked := range lockedUsers {
+		require.NotEqual(t, userID, gokeycloak.PString(locked.User.ID), "a new user must not be locked")
+	}
+}
+
 func Test_GetUserSessions(t *testing.T) {
 	t.Parallel()
 	cfg := GetConfig(t)
@@ -3385,6 +3794,60 @@ func Test_CreateUpdateDeleteClientProtocolMapper(t *testing.T) {
 	)
 }
 
+func Test_GetClientSessionStatsAndCount(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	SetUpTestUser(t, client)
+	_, _, err := client.GetToken(
+		context.Background(),
+		cfg.GoKeycloak.Realm,
+		gokeycloak.TokenOptions{
+			ClientID:     &cfg.GoKeycloak.ClientID,
+			ClientSecret: &cfg.GoKeycloak.ClientSecret,
+			Username:     &cfg.GoKeycloak.UserName,
+			Password:     &cfg.GoKeycloak.Password,
+			GrantType:    gokeycloak.StringP("password"),
+		},
+	)
+	require.NoError(t, err, "Login failed")
+	token := GetAdminToken(t, client)
+	testClient := GetClientByClientID(t, client, cfg.GoKeycloak.ClientID)
+
+	_, stats, err := client.GetClientSessionStats(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+	)
+	require.NoError(t, err, "GetClientSessionStats failed")
+	var found bool
+	for _, stat := range stats {
+		if gokeycloak.PString(stat.ID) == gokeycloak.PString(testClient.ID) {
+			found = true
+			require.True(t, gokeycloak.PInt64(stat.Active) > 0)
+		}
+	}
+	require.True(t, found, "GetClientSessionStats has no stats of the test client")
+
+	_, count, err := client.GetClientSessionCount(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		*testClient.ID,
+	)
+	require.NoError(t, err, "GetClientSessionCount failed")
+	require.True(t, count > 0)
+
+	inventory, err := client.GetSessionInventory(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		gokeycloak.SessionInventoryOptions{ClientIDs: []string{cfg.GoKeycloak.ClientID}},
+	)
+	require.NoError(t, err, "GetSessionInventory failed")
+	require.NotEmpty(t, inventory.ByUser[testUserID])
+}
+
 func Test_GetClientOfflineSessions(t *testing.T) {
 	t.Parallel()
 	cfg := GetConfig(t)
@@ -6343,6 +6806,99 @@ func TestGocloak_CreateAuthenticationFlowsAndCreateAuthenticationExecutionAndFlo
 	require.NoError(t, err, "Failed to delete authentication flow")
 }
 
+func TestGocloak_CopyAuthenticationFlowAndAuthenticatorConfig(t *testing.T) {
+	t.Parallel()
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+	alias := GetRandomName("browser-copy")
+
+	_, err := client.CopyAuthenticationFlow(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		"browser",
+		alias,
+	)
+	require.NoError(t, err, "Failed to copy authentication flow")
+	defer func() {
+		_, flows, err := client.GetAuthenticationFlows(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm)
+		require.NoError(t, err, "Failed to get authentication flows")
+		for _, flow := range flows {
+			if gokeycloak.PString(flow.Alias) == alias {
+				_, err = client.DeleteAuthenticationFlow(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, *flow.ID)
+				require.NoError(t, err, "Failed to delete authentication flow")
+			}
+		}
+	}()
+
+	_, executions, err := client.GetAuthenticationExecutions(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, alias)
+	require.NoError(t, err, "Failed to get authentication executions")
+	require.True(t, len(executions) > 1)
+	first := *executions[0].ID
+
+	_, err = client.LowerAuthenticationExecutionPriority(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, first)
+	require.NoError(t, err, "Failed to lower authentication execution priority")
+	_, executions, err = client.GetAuthenticationExecutions(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, alias)
+	require.NoError(t, err, "Failed to get authentication executions")
+	require.Equal(t, first, *executions[1].ID)
+
+	_, err = client.RaiseAuthenticationExecutionPriority(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, first)
+	require.NoError(t, err, "Failed to raise authentication execution priority")
+	_, executions, err = client.GetAuthenticationExecutions(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, alias)
+	require.NoError(t, err, "Failed to get authentication executions")
+	require.Equal(t, first, *executions[0].ID)
+
+	var redirector string
+	for _, execution := range executions {
+		if gokeycloak.PString(execution.ProviderID) == "identity-provider-redirector" {
+			redirector = *execution.ID
+		}
+	}
+	require.NotEmpty(t, redirector, "identity-provider-redirector execution not found")
+
+	_, description, err := client.GetAuthenticatorConfigDescription(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, "identity-provider-redirector")
+	require.NoError(t, err, "Failed to get authenticator config description")
+	require.NotEmpty(t, *description.Properties)
+
+	_, configID, err := client.CreateAuthenticatorConfig(
+		context.Background(),
+		token.AccessToken,
+		cfg.GoKeycloak.Realm,
+		redirector,
+		gokeycloak.AuthenticatorConfigRepresentation{
+			Alias:  gokeycloak.StringP(GetRandomName("redirector")),
+			Config: &map[string]string{"defaultProvider": "idp"},
+		},
+	)
+	require.NoError(t, err, "Failed to create authenticator config")
+
+	_, config, err := client.GetAuthenticatorConfig(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, configID)
+	require.NoError(t, err, "Failed to get authenticator config")
+	require.Equal(t, "idp", (*config.Config)["defaultProvider"])
+
+	(*config.Config)["defaultProvider"] = "other-idp"
+	_, err = client.UpdateAuthenticatorConfig(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, *config)
+	require.NoError(t, err, "Failed to update authenticator config")
+	_, config, err = client.GetAuthenticatorConfig(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, configID)
+	require.NoError(t, err, "Failed to get authenticator config")
+	require.Equal(t, "other-idp", (*config.Config)["defaultProvider"])
+
+	_, err = client.DeleteAuthenticatorConfig(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm, configID)
+	require.NoError(t, err, "Failed to delete authenticator config")
+
+	for _, list := range []func(context.Context, string, string) (int, []*gokeycloak.AuthenticationProviderRepresentation, error){
+		client.GetAuthenticatorProviders,
+		client.GetFormProviders,
+		client.GetFormActionProviders,
+		client.GetClientAuthenticatorProviders,
+	} {
+		_, providers, err := list(context.Background(), token.AccessToken, cfg.GoKeycloak.Realm)
+		require.NoError(t, err, "Failed to get authentication providers")
+		require.NotEmpty(t, providers)
+	}
+}
+
 func TestGocloak_CreateAndGetRequiredAction(t *testing.T) {
 	t.Parallel()
 	cfg := GetConfig(t)
@@ -0,0 +1,2489 @@
+// Code generated by genv2. DO NOT EDIT.
+
+package gokeycloak
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// AddClientPolicy calls GoKeycloak.AddClientPolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddClientPolicy(ctx context.Context, token string, realm string, policy ClientPolicyDefinition, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddClientPolicy", realm, opts)
+	_, err := v.g.AddClientPolicy(ctx, token, realm, policy)
+	return call.end(err), err
+}
+
+// AddClientProfile calls GoKeycloak.AddClientProfile and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddClientProfile(ctx context.Context, token string, realm string, profile ClientProfileRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddClientProfile", realm, opts)
+	_, err := v.g.AddClientProfile(ctx, token, realm, profile)
+	return call.end(err), err
+}
+
+// AddClientRoleComposite calls GoKeycloak.AddClientRoleComposite and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddClientRoleComposite(ctx context.Context, token string, realm string, roleID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddClientRoleComposite", realm, opts)
+	_, err := v.g.AddClientRoleComposite(ctx, token, realm, roleID, roles)
+	return call.end(err), err
+}
+
+// AddClientRolesToGroup calls GoKeycloak.AddClientRolesToGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddClientRolesToGroup(ctx context.Context, token string, realm string, idOfClient string, groupID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddClientRolesToGroup", realm, opts)
+	_, err := v.g.AddClientRolesToGroup(ctx, token, realm, idOfClient, groupID, roles)
+	return call.end(err), err
+}
+
+// AddClientRolesToUser calls GoKeycloak.AddClientRolesToUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddClientRolesToUser(ctx context.Context, token string, realm string, idOfClient string, userID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddClientRolesToUser", realm, opts)
+	_, err := v.g.AddClientRolesToUser(ctx, token, realm, idOfClient, userID, roles)
+	return call.end(err), err
+}
+
+// AddDefaultGroup calls GoKeycloak.AddDefaultGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddDefaultGroup(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddDefaultGroup", realm, opts)
+	err := v.g.AddDefaultGroup(ctx, token, realm, groupID)
+	return call.end(err), err
+}
+
+// AddDefaultScopeToClient calls GoKeycloak.AddDefaultScopeToClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddDefaultScopeToClient(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddDefaultScopeToClient", realm, opts)
+	_, err := v.g.AddDefaultScopeToClient(ctx, token, realm, idOfClient, scopeID)
+	return call.end(err), err
+}
+
+// AddOptionalScopeToClient calls GoKeycloak.AddOptionalScopeToClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddOptionalScopeToClient(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddOptionalScopeToClient", realm, opts)
+	_, err := v.g.AddOptionalScopeToClient(ctx, token, realm, idOfClient, scopeID)
+	return call.end(err), err
+}
+
+// AddRealmRoleComposite calls GoKeycloak.AddRealmRoleComposite and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddRealmRoleComposite(ctx context.Context, token string, realm string, roleName string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddRealmRoleComposite", realm, opts)
+	_, err := v.g.AddRealmRoleComposite(ctx, token, realm, roleName, roles)
+	return call.end(err), err
+}
+
+// AddRealmRoleToGroup calls GoKeycloak.AddRealmRoleToGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddRealmRoleToGroup(ctx context.Context, token string, realm string, groupID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddRealmRoleToGroup", realm, opts)
+	_, err := v.g.AddRealmRoleToGroup(ctx, token, realm, groupID, roles)
+	return call.end(err), err
+}
+
+// AddRealmRoleToUser calls GoKeycloak.AddRealmRoleToUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddRealmRoleToUser(ctx context.Context, token string, realm string, userID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddRealmRoleToUser", realm, opts)
+	_, err := v.g.AddRealmRoleToUser(ctx, token, realm, userID, roles)
+	return call.end(err), err
+}
+
+// AddUserToGroup calls GoKeycloak.AddUserToGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) AddUserToGroup(ctx context.Context, token string, realm string, userID string, groupID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "AddUserToGroup", realm, opts)
+	_, err := v.g.AddUserToGroup(ctx, token, realm, userID, groupID)
+	return call.end(err), err
+}
+
+// ApplyAuthenticationFlow calls GoKeycloak.ApplyAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ApplyAuthenticationFlow(ctx context.Context, token string, realm string, flow *FlowBuilder, opts ...RequestOption) ([]FlowDrift, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ApplyAuthenticationFlow", realm, opts)
+	res0, err := v.g.ApplyAuthenticationFlow(ctx, token, realm, flow)
+	return res0, call.end(err), err
+}
+
+// ApplyRealmLocalization calls GoKeycloak.ApplyRealmLocalization and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ApplyRealmLocalization(ctx context.Context, token string, realm string, dir string, prune bool, opts ...RequestOption) ([]LocalizationChange, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ApplyRealmLocalization", realm, opts)
+	res0, err := v.g.ApplyRealmLocalization(ctx, token, realm, dir, prune)
+	return res0, call.end(err), err
+}
+
+// ApplyRealmPlan calls GoKeycloak.ApplyRealmPlan and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ApplyRealmPlan(ctx context.Context, token string, plan *RealmPlan, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ApplyRealmPlan", "", opts)
+	err := v.g.ApplyRealmPlan(ctx, token, plan)
+	return call.end(err), err
+}
+
+// ClearAllLoginFailures calls GoKeycloak.ClearAllLoginFailures and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ClearAllLoginFailures(ctx context.Context, accessToken string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ClearAllLoginFailures", realm, opts)
+	_, err := v.g.ClearAllLoginFailures(ctx, accessToken, realm)
+	return call.end(err), err
+}
+
+// ClearKeysCache calls GoKeycloak.ClearKeysCache and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ClearKeysCache(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ClearKeysCache", realm, opts)
+	_, err := v.g.ClearKeysCache(ctx, token, realm)
+	return call.end(err), err
+}
+
+// ClearRealmCache calls GoKeycloak.ClearRealmCache and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ClearRealmCache(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ClearRealmCache", realm, opts)
+	_, err := v.g.ClearRealmCache(ctx, token, realm)
+	return call.end(err), err
+}
+
+// ClearUserCache calls GoKeycloak.ClearUserCache and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ClearUserCache(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ClearUserCache", realm, opts)
+	_, err := v.g.ClearUserCache(ctx, token, realm)
+	return call.end(err), err
+}
+
+// ClearUserLoginFailures calls GoKeycloak.ClearUserLoginFailures and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ClearUserLoginFailures(ctx context.Context, accessToken string, realm string, userID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ClearUserLoginFailures", realm, opts)
+	_, err := v.g.ClearUserLoginFailures(ctx, accessToken, realm, userID)
+	return call.end(err), err
+}
+
+// CopyAuthenticationFlow calls GoKeycloak.CopyAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CopyAuthenticationFlow(ctx context.Context, token string, realm string, flow string, newName string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CopyAuthenticationFlow", realm, opts)
+	_, err := v.g.CopyAuthenticationFlow(ctx, token, realm, flow, newName)
+	return call.end(err), err
+}
+
+// CreateAuthenticationExecution calls GoKeycloak.CreateAuthenticationExecution and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateAuthenticationExecution(ctx context.Context, token string, realm string, flow string, execution CreateAuthenticationExecutionRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateAuthenticationExecution", realm, opts)
+	_, err := v.g.CreateAuthenticationExecution(ctx, token, realm, flow, execution)
+	return call.end(err), err
+}
+
+// CreateAuthenticationExecutionFlow calls GoKeycloak.CreateAuthenticationExecutionFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateAuthenticationExecutionFlow(ctx context.Context, token string, realm string, flow string, executionFlow CreateAuthenticationExecutionFlowRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateAuthenticationExecutionFlow", realm, opts)
+	_, err := v.g.CreateAuthenticationExecutionFlow(ctx, token, realm, flow, executionFlow)
+	return call.end(err), err
+}
+
+// CreateAuthenticationFlow calls GoKeycloak.CreateAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateAuthenticationFlow(ctx context.Context, token string, realm string, flow AuthenticationFlowRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateAuthenticationFlow", realm, opts)
+	_, err := v.g.CreateAuthenticationFlow(ctx, token, realm, flow)
+	return call.end(err), err
+}
+
+// CreateAuthenticatorConfig calls GoKeycloak.CreateAuthenticatorConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateAuthenticatorConfig(ctx context.Context, token string, realm string, executionID string, config AuthenticatorConfigRepresentation, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateAuthenticatorConfig", realm, opts)
+	_, res0, err := v.g.CreateAuthenticatorConfig(ctx, token, realm, executionID, config)
+	return res0, call.end(err), err
+}
+
+// CreateChildGroup calls GoKeycloak.CreateChildGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateChildGroup(ctx context.Context, token string, realm string, groupID string, group Group, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateChildGroup", realm, opts)
+	_, res0, err := v.g.CreateChildGroup(ctx, token, realm, groupID, group)
+	return res0, call.end(err), err
+}
+
+// CreateClient calls GoKeycloak.CreateClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClient(ctx context.Context, clientInitialAccessToken string, realm string, newClient Client, opts ...RequestOption) (CreateClientResponse, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClient", realm, opts)
+	_, res0, err := v.g.CreateClient(ctx, clientInitialAccessToken, realm, newClient)
+	return res0, call.end(err), err
+}
+
+// CreateClientJWTSigner calls GoKeycloak.CreateClientJWTSigner and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientJWTSigner(ctx context.Context, token string, realm string, idOfClient string, validFor time.Duration, opts ...RequestOption) (*ClientJWTSigner, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientJWTSigner", realm, opts)
+	res0, err := v.g.CreateClientJWTSigner(ctx, token, realm, idOfClient, validFor)
+	return res0, call.end(err), err
+}
+
+// CreateClientProtocolMapper calls GoKeycloak.CreateClientProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientProtocolMapper(ctx context.Context, token string, realm string, idOfClient string, mapper ProtocolMapperRepresentation, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientProtocolMapper", realm, opts)
+	_, res0, err := v.g.CreateClientProtocolMapper(ctx, token, realm, idOfClient, mapper)
+	return res0, call.end(err), err
+}
+
+// CreateClientRepresentation calls GoKeycloak.CreateClientRepresentation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientRepresentation(ctx context.Context, token string, realm string, newClient Client, opts ...RequestOption) (*Client, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientRepresentation", realm, opts)
+	_, res0, err := v.g.CreateClientRepresentation(ctx, token, realm, newClient)
+	return res0, call.end(err), err
+}
+
+// CreateClientRole calls GoKeycloak.CreateClientRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientRole(ctx context.Context, token string, realm string, idOfClient string, role Role, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientRole", realm, opts)
+	_, res0, err := v.g.CreateClientRole(ctx, token, realm, idOfClient, role)
+	return res0, call.end(err), err
+}
+
+// CreateClientScope calls GoKeycloak.CreateClientScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScope(ctx context.Context, token string, realm string, scope ClientScope, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScope", realm, opts)
+	_, res0, err := v.g.CreateClientScope(ctx, token, realm, scope)
+	return res0, call.end(err), err
+}
+
+// CreateClientScopeMappingsClientRoles calls GoKeycloak.CreateClientScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClient string, idOfSelectedClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScopeMappingsClientRoles", realm, opts)
+	_, err := v.g.CreateClientScopeMappingsClientRoles(ctx, token, realm, idOfClient, idOfSelectedClient, roles)
+	return call.end(err), err
+}
+
+// CreateClientScopeMappingsRealmRoles calls GoKeycloak.CreateClientScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScopeMappingsRealmRoles(ctx context.Context, token string, realm string, idOfClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScopeMappingsRealmRoles", realm, opts)
+	_, err := v.g.CreateClientScopeMappingsRealmRoles(ctx, token, realm, idOfClient, roles)
+	return call.end(err), err
+}
+
+// CreateClientScopeProtocolMapper calls GoKeycloak.CreateClientScopeProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScopeProtocolMapper(ctx context.Context, token string, realm string, scopeID string, protocolMapper ProtocolMappers, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScopeProtocolMapper", realm, opts)
+	_, res0, err := v.g.CreateClientScopeProtocolMapper(ctx, token, realm, scopeID, protocolMapper)
+	return res0, call.end(err), err
+}
+
+// CreateClientScopesScopeMappingsClientRoles calls GoKeycloak.CreateClientScopesScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScopesScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClientScope string, idOfClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScopesScopeMappingsClientRoles", realm, opts)
+	_, err := v.g.CreateClientScopesScopeMappingsClientRoles(ctx, token, realm, idOfClientScope, idOfClient, roles)
+	return call.end(err), err
+}
+
+// CreateClientScopesScopeMappingsRealmRoles calls GoKeycloak.CreateClientScopesScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateClientScopesScopeMappingsRealmRoles(ctx context.Context, token string, realm string, clientScopeID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateClientScopesScopeMappingsRealmRoles", realm, opts)
+	_, err := v.g.CreateClientScopesScopeMappingsRealmRoles(ctx, token, realm, clientScopeID, roles)
+	return call.end(err), err
+}
+
+// CreateComponent calls GoKeycloak.CreateComponent and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateComponent(ctx context.Context, token string, realm string, component Component, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateComponent", realm, opts)
+	_, res0, err := v.g.CreateComponent(ctx, token, realm, component)
+	return res0, call.end(err), err
+}
+
+// CreateGroup calls GoKeycloak.CreateGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateGroup(ctx context.Context, token string, realm string, group Group, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateGroup", realm, opts)
+	_, res0, err := v.g.CreateGroup(ctx, token, realm, group)
+	return res0, call.end(err), err
+}
+
+// CreateIdentityProvider calls GoKeycloak.CreateIdentityProvider and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateIdentityProvider(ctx context.Context, token string, realm string, providerRep IdentityProviderRepresentation, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateIdentityProvider", realm, opts)
+	_, res0, err := v.g.CreateIdentityProvider(ctx, token, realm, providerRep)
+	return res0, call.end(err), err
+}
+
+// CreateIdentityProviderMapper calls GoKeycloak.CreateIdentityProviderMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateIdentityProviderMapper(ctx context.Context, token string, realm string, alias string, mapper IdentityProviderMapper, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateIdentityProviderMapper", realm, opts)
+	_, res0, err := v.g.CreateIdentityProviderMapper(ctx, token, realm, alias, mapper)
+	return res0, call.end(err), err
+}
+
+// CreatePermission calls GoKeycloak.CreatePermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreatePermission(ctx context.Context, token string, realm string, idOfClient string, permission PermissionRepresentation, opts ...RequestOption) (*PermissionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreatePermission", realm, opts)
+	res0, err := v.g.CreatePermission(ctx, token, realm, idOfClient, permission)
+	return res0, call.end(err), err
+}
+
+// CreatePermissionTicket calls GoKeycloak.CreatePermissionTicket and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreatePermissionTicket(ctx context.Context, token string, realm string, permissions []CreatePermissionTicketParams, opts ...RequestOption) (*PermissionTicketResponseRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreatePermissionTicket", realm, opts)
+	res0, err := v.g.CreatePermissionTicket(ctx, token, realm, permissions)
+	return res0, call.end(err), err
+}
+
+// CreatePolicy calls GoKeycloak.CreatePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreatePolicy(ctx context.Context, token string, realm string, idOfClient string, policy PolicyRepresentation, opts ...RequestOption) (*PolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreatePolicy", realm, opts)
+	res0, err := v.g.CreatePolicy(ctx, token, realm, idOfClient, policy)
+	return res0, call.end(err), err
+}
+
+// CreateRealm calls GoKeycloak.CreateRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateRealm(ctx context.Context, token string, realm RealmRepresentation, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateRealm", PString(realm.Realm), opts)
+	_, res0, err := v.g.CreateRealm(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// CreateRealmRole calls GoKeycloak.CreateRealmRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateRealmRole(ctx context.Context, token string, realm string, role Role, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateRealmRole", realm, opts)
+	_, res0, err := v.g.CreateRealmRole(ctx, token, realm, role)
+	return res0, call.end(err), err
+}
+
+// CreateResource calls GoKeycloak.CreateResource and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateResource(ctx context.Context, token string, realm string, idOfClient string, resource ResourceRepresentation, opts ...RequestOption) (*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateResource", realm, opts)
+	_, res0, err := v.g.CreateResource(ctx, token, realm, idOfClient, resource)
+	return res0, call.end(err), err
+}
+
+// CreateResourceClient calls GoKeycloak.CreateResourceClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateResourceClient(ctx context.Context, token string, realm string, resource ResourceRepresentation, opts ...RequestOption) (*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateResourceClient", realm, opts)
+	_, res0, err := v.g.CreateResourceClient(ctx, token, realm, resource)
+	return res0, call.end(err), err
+}
+
+// CreateResourcePolicy calls GoKeycloak.CreateResourcePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateResourcePolicy(ctx context.Context, token string, realm string, resourceID string, policy ResourcePolicyRepresentation, opts ...RequestOption) (*ResourcePolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateResourcePolicy", realm, opts)
+	res0, err := v.g.CreateResourcePolicy(ctx, token, realm, resourceID, policy)
+	return res0, call.end(err), err
+}
+
+// CreateScope calls GoKeycloak.CreateScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateScope(ctx context.Context, token string, realm string, idOfClient string, scope ScopeRepresentation, opts ...RequestOption) (*ScopeRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateScope", realm, opts)
+	_, res0, err := v.g.CreateScope(ctx, token, realm, idOfClient, scope)
+	return res0, call.end(err), err
+}
+
+// CreateUser calls GoKeycloak.CreateUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateUser(ctx context.Context, token string, realm string, user User, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateUser", realm, opts)
+	_, res0, err := v.g.CreateUser(ctx, token, realm, user)
+	return res0, call.end(err), err
+}
+
+// CreateUserFederatedIdentity calls GoKeycloak.CreateUserFederatedIdentity and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) CreateUserFederatedIdentity(ctx context.Context, token string, realm string, userID string, providerID string, federatedIdentityRep FederatedIdentityRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "CreateUserFederatedIdentity", realm, opts)
+	_, err := v.g.CreateUserFederatedIdentity(ctx, token, realm, userID, providerID, federatedIdentityRep)
+	return call.end(err), err
+}
+
+// DecodeAccessToken calls GoKeycloak.DecodeAccessToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DecodeAccessToken(ctx context.Context, accessToken string, realm string, opts ...RequestOption) (*jwt.Token, *jwt.MapClaims, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DecodeAccessToken", realm, opts)
+	_, res0, res1, err := v.g.DecodeAccessToken(ctx, accessToken, realm)
+	return res0, res1, call.end(err), err
+}
+
+// DecodeAccessTokenCustomClaims calls GoKeycloak.DecodeAccessTokenCustomClaims and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DecodeAccessTokenCustomClaims(ctx context.Context, accessToken string, realm string, claims jwt.Claims, opts ...RequestOption) (*jwt.Token, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DecodeAccessTokenCustomClaims", realm, opts)
+	_, res0, err := v.g.DecodeAccessTokenCustomClaims(ctx, accessToken, realm, claims)
+	return res0, call.end(err), err
+}
+
+// DeleteAdminEvents calls GoKeycloak.DeleteAdminEvents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteAdminEvents(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteAdminEvents", realm, opts)
+	_, err := v.g.DeleteAdminEvents(ctx, token, realm)
+	return call.end(err), err
+}
+
+// DeleteAuthenticationExecution calls GoKeycloak.DeleteAuthenticationExecution and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteAuthenticationExecution(ctx context.Context, token string, realm string, executionID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteAuthenticationExecution", realm, opts)
+	_, err := v.g.DeleteAuthenticationExecution(ctx, token, realm, executionID)
+	return call.end(err), err
+}
+
+// DeleteAuthenticationFlow calls GoKeycloak.DeleteAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteAuthenticationFlow(ctx context.Context, token string, realm string, flowID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteAuthenticationFlow", realm, opts)
+	_, err := v.g.DeleteAuthenticationFlow(ctx, token, realm, flowID)
+	return call.end(err), err
+}
+
+// DeleteAuthenticatorConfig calls GoKeycloak.DeleteAuthenticatorConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteAuthenticatorConfig(ctx context.Context, token string, realm string, configID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteAuthenticatorConfig", realm, opts)
+	_, err := v.g.DeleteAuthenticatorConfig(ctx, token, realm, configID)
+	return call.end(err), err
+}
+
+// DeleteClient calls GoKeycloak.DeleteClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClient(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClient", realm, opts)
+	_, err := v.g.DeleteClient(ctx, token, realm, idOfClient)
+	return call.end(err), err
+}
+
+// DeleteClientProtocolMapper calls GoKeycloak.DeleteClientProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientProtocolMapper(ctx context.Context, token string, realm string, idOfClient string, mapperID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientProtocolMapper", realm, opts)
+	_, err := v.g.DeleteClientProtocolMapper(ctx, token, realm, idOfClient, mapperID)
+	return call.end(err), err
+}
+
+// DeleteClientRepresentation calls GoKeycloak.DeleteClientRepresentation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientRepresentation(ctx context.Context, accessToken string, realm string, clientID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientRepresentation", realm, opts)
+	_, err := v.g.DeleteClientRepresentation(ctx, accessToken, realm, clientID)
+	return call.end(err), err
+}
+
+// DeleteClientRole calls GoKeycloak.DeleteClientRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientRole(ctx context.Context, token string, realm string, idOfClient string, roleName string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientRole", realm, opts)
+	_, err := v.g.DeleteClientRole(ctx, token, realm, idOfClient, roleName)
+	return call.end(err), err
+}
+
+// DeleteClientRoleComposite calls GoKeycloak.DeleteClientRoleComposite and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientRoleComposite(ctx context.Context, token string, realm string, roleID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientRoleComposite", realm, opts)
+	_, err := v.g.DeleteClientRoleComposite(ctx, token, realm, roleID, roles)
+	return call.end(err), err
+}
+
+// DeleteClientRoleFromGroup calls GoKeycloak.DeleteClientRoleFromGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientRoleFromGroup(ctx context.Context, token string, realm string, idOfClient string, groupID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientRoleFromGroup", realm, opts)
+	_, err := v.g.DeleteClientRoleFromGroup(ctx, token, realm, idOfClient, groupID, roles)
+	return call.end(err), err
+}
+
+// DeleteClientRolesFromUser calls GoKeycloak.DeleteClientRolesFromUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientRolesFromUser(ctx context.Context, token string, realm string, idOfClient string, userID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientRolesFromUser", realm, opts)
+	_, err := v.g.DeleteClientRolesFromUser(ctx, token, realm, idOfClient, userID, roles)
+	return call.end(err), err
+}
+
+// DeleteClientScope calls GoKeycloak.DeleteClientScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScope(ctx context.Context, token string, realm string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScope", realm, opts)
+	_, err := v.g.DeleteClientScope(ctx, token, realm, scopeID)
+	return call.end(err), err
+}
+
+// DeleteClientScopeMappingsClientRoles calls GoKeycloak.DeleteClientScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClient string, idOfSelectedClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScopeMappingsClientRoles", realm, opts)
+	_, err := v.g.DeleteClientScopeMappingsClientRoles(ctx, token, realm, idOfClient, idOfSelectedClient, roles)
+	return call.end(err), err
+}
+
+// DeleteClientScopeMappingsRealmRoles calls GoKeycloak.DeleteClientScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScopeMappingsRealmRoles(ctx context.Context, token string, realm string, idOfClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScopeMappingsRealmRoles", realm, opts)
+	_, err := v.g.DeleteClientScopeMappingsRealmRoles(ctx, token, realm, idOfClient, roles)
+	return call.end(err), err
+}
+
+// DeleteClientScopeProtocolMapper calls GoKeycloak.DeleteClientScopeProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScopeProtocolMapper(ctx context.Context, token string, realm string, scopeID string, protocolMapperID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScopeProtocolMapper", realm, opts)
+	_, err := v.g.DeleteClientScopeProtocolMapper(ctx, token, realm, scopeID, protocolMapperID)
+	return call.end(err), err
+}
+
+// DeleteClientScopesScopeMappingsClientRoles calls GoKeycloak.DeleteClientScopesScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScopesScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClientScope string, idOfClient string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScopesScopeMappingsClientRoles", realm, opts)
+	_, err := v.g.DeleteClientScopesScopeMappingsClientRoles(ctx, token, realm, idOfClientScope, idOfClient, roles)
+	return call.end(err), err
+}
+
+// DeleteClientScopesScopeMappingsRealmRoles calls GoKeycloak.DeleteClientScopesScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteClientScopesScopeMappingsRealmRoles(ctx context.Context, token string, realm string, clientScopeID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteClientScopesScopeMappingsRealmRoles", realm, opts)
+	_, err := v.g.DeleteClientScopesScopeMappingsRealmRoles(ctx, token, realm, clientScopeID, roles)
+	return call.end(err), err
+}
+
+// DeleteComponent calls GoKeycloak.DeleteComponent and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteComponent(ctx context.Context, token string, realm string, componentID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteComponent", realm, opts)
+	_, err := v.g.DeleteComponent(ctx, token, realm, componentID)
+	return call.end(err), err
+}
+
+// DeleteCredentials calls GoKeycloak.DeleteCredentials and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteCredentials(ctx context.Context, token string, realm string, userID string, credentialID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteCredentials", realm, opts)
+	err := v.g.DeleteCredentials(ctx, token, realm, userID, credentialID)
+	return call.end(err), err
+}
+
+// DeleteEvents calls GoKeycloak.DeleteEvents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteEvents(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteEvents", realm, opts)
+	_, err := v.g.DeleteEvents(ctx, token, realm)
+	return call.end(err), err
+}
+
+// DeleteGroup calls GoKeycloak.DeleteGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteGroup(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteGroup", realm, opts)
+	_, err := v.g.DeleteGroup(ctx, token, realm, groupID)
+	return call.end(err), err
+}
+
+// DeleteIdentityProvider calls GoKeycloak.DeleteIdentityProvider and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteIdentityProvider(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteIdentityProvider", realm, opts)
+	_, err := v.g.DeleteIdentityProvider(ctx, token, realm, alias)
+	return call.end(err), err
+}
+
+// DeleteIdentityProviderMapper calls GoKeycloak.DeleteIdentityProviderMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteIdentityProviderMapper(ctx context.Context, token string, realm string, alias string, mapperID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteIdentityProviderMapper", realm, opts)
+	_, err := v.g.DeleteIdentityProviderMapper(ctx, token, realm, alias, mapperID)
+	return call.end(err), err
+}
+
+// DeletePermission calls GoKeycloak.DeletePermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeletePermission(ctx context.Context, token string, realm string, idOfClient string, permissionID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeletePermission", realm, opts)
+	err := v.g.DeletePermission(ctx, token, realm, idOfClient, permissionID)
+	return call.end(err), err
+}
+
+// DeletePolicy calls GoKeycloak.DeletePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeletePolicy(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeletePolicy", realm, opts)
+	err := v.g.DeletePolicy(ctx, token, realm, idOfClient, policyID)
+	return call.end(err), err
+}
+
+// DeleteRealm calls GoKeycloak.DeleteRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealm(ctx context.Context, token string, realm string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealm", realm, opts)
+	_, err := v.g.DeleteRealm(ctx, token, realm)
+	return call.end(err), err
+}
+
+// DeleteRealmLocalizationText calls GoKeycloak.DeleteRealmLocalizationText and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmLocalizationText(ctx context.Context, token string, realm string, locale string, key string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmLocalizationText", realm, opts)
+	_, err := v.g.DeleteRealmLocalizationText(ctx, token, realm, locale, key)
+	return call.end(err), err
+}
+
+// DeleteRealmLocalizationTexts calls GoKeycloak.DeleteRealmLocalizationTexts and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmLocalizationTexts(ctx context.Context, token string, realm string, locale string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmLocalizationTexts", realm, opts)
+	_, err := v.g.DeleteRealmLocalizationTexts(ctx, token, realm, locale)
+	return call.end(err), err
+}
+
+// DeleteRealmRole calls GoKeycloak.DeleteRealmRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmRole(ctx context.Context, token string, realm string, roleName string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmRole", realm, opts)
+	_, err := v.g.DeleteRealmRole(ctx, token, realm, roleName)
+	return call.end(err), err
+}
+
+// DeleteRealmRoleComposite calls GoKeycloak.DeleteRealmRoleComposite and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmRoleComposite(ctx context.Context, token string, realm string, roleName string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmRoleComposite", realm, opts)
+	_, err := v.g.DeleteRealmRoleComposite(ctx, token, realm, roleName, roles)
+	return call.end(err), err
+}
+
+// DeleteRealmRoleFromGroup calls GoKeycloak.DeleteRealmRoleFromGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmRoleFromGroup(ctx context.Context, token string, realm string, groupID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmRoleFromGroup", realm, opts)
+	_, err := v.g.DeleteRealmRoleFromGroup(ctx, token, realm, groupID, roles)
+	return call.end(err), err
+}
+
+// DeleteRealmRoleFromUser calls GoKeycloak.DeleteRealmRoleFromUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRealmRoleFromUser(ctx context.Context, token string, realm string, userID string, roles []Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRealmRoleFromUser", realm, opts)
+	_, err := v.g.DeleteRealmRoleFromUser(ctx, token, realm, userID, roles)
+	return call.end(err), err
+}
+
+// DeleteRequiredAction calls GoKeycloak.DeleteRequiredAction and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteRequiredAction(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteRequiredAction", realm, opts)
+	_, err := v.g.DeleteRequiredAction(ctx, token, realm, alias)
+	return call.end(err), err
+}
+
+// DeleteResource calls GoKeycloak.DeleteResource and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteResource(ctx context.Context, token string, realm string, idOfClient string, resourceID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteResource", realm, opts)
+	_, err := v.g.DeleteResource(ctx, token, realm, idOfClient, resourceID)
+	return call.end(err), err
+}
+
+// DeleteResourceClient calls GoKeycloak.DeleteResourceClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteResourceClient(ctx context.Context, token string, realm string, resourceID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteResourceClient", realm, opts)
+	_, err := v.g.DeleteResourceClient(ctx, token, realm, resourceID)
+	return call.end(err), err
+}
+
+// DeleteResourcePolicy calls GoKeycloak.DeleteResourcePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteResourcePolicy(ctx context.Context, token string, realm string, permissionID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteResourcePolicy", realm, opts)
+	err := v.g.DeleteResourcePolicy(ctx, token, realm, permissionID)
+	return call.end(err), err
+}
+
+// DeleteScope calls GoKeycloak.DeleteScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteScope(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteScope", realm, opts)
+	_, err := v.g.DeleteScope(ctx, token, realm, idOfClient, scopeID)
+	return call.end(err), err
+}
+
+// DeleteUser calls GoKeycloak.DeleteUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteUser(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteUser", realm, opts)
+	_, err := v.g.DeleteUser(ctx, token, realm, userID)
+	return call.end(err), err
+}
+
+// DeleteUserFederatedIdentity calls GoKeycloak.DeleteUserFederatedIdentity and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteUserFederatedIdentity(ctx context.Context, token string, realm string, userID string, providerID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteUserFederatedIdentity", realm, opts)
+	_, err := v.g.DeleteUserFederatedIdentity(ctx, token, realm, userID, providerID)
+	return call.end(err), err
+}
+
+// DeleteUserFromGroup calls GoKeycloak.DeleteUserFromGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteUserFromGroup(ctx context.Context, token string, realm string, userID string, groupID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteUserFromGroup", realm, opts)
+	_, err := v.g.DeleteUserFromGroup(ctx, token, realm, userID, groupID)
+	return call.end(err), err
+}
+
+// DeleteUserPermission calls GoKeycloak.DeleteUserPermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DeleteUserPermission(ctx context.Context, token string, realm string, ticketID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DeleteUserPermission", realm, opts)
+	err := v.g.DeleteUserPermission(ctx, token, realm, ticketID)
+	return call.end(err), err
+}
+
+// DiffAuthenticationFlow calls GoKeycloak.DiffAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DiffAuthenticationFlow(ctx context.Context, token string, realm string, flow *FlowBuilder, opts ...RequestOption) ([]FlowDrift, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DiffAuthenticationFlow", realm, opts)
+	res0, err := v.g.DiffAuthenticationFlow(ctx, token, realm, flow)
+	return res0, call.end(err), err
+}
+
+// DiffRealmLocalization calls GoKeycloak.DiffRealmLocalization and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DiffRealmLocalization(ctx context.Context, token string, realm string, dir string, prune bool, opts ...RequestOption) ([]LocalizationChange, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DiffRealmLocalization", realm, opts)
+	res0, err := v.g.DiffRealmLocalization(ctx, token, realm, dir, prune)
+	return res0, call.end(err), err
+}
+
+// DisableAllCredentialsByType calls GoKeycloak.DisableAllCredentialsByType and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DisableAllCredentialsByType(ctx context.Context, token string, realm string, userID string, types []string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DisableAllCredentialsByType", realm, opts)
+	err := v.g.DisableAllCredentialsByType(ctx, token, realm, userID, types)
+	return call.end(err), err
+}
+
+// DownloadClientKeyStore calls GoKeycloak.DownloadClientKeyStore and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) DownloadClientKeyStore(ctx context.Context, token string, realm string, idOfClient string, attr string, config ClientKeyStoreConfig, opts ...RequestOption) ([]byte, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "DownloadClientKeyStore", realm, opts)
+	_, res0, err := v.g.DownloadClientKeyStore(ctx, token, realm, idOfClient, attr, config)
+	return res0, call.end(err), err
+}
+
+// EvaluateClientScopes calls GoKeycloak.EvaluateClientScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) EvaluateClientScopes(ctx context.Context, token string, realm string, idOfClient string, userID string, scope string, opts ...RequestOption) (*ScopeEvaluation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "EvaluateClientScopes", realm, opts)
+	res0, err := v.g.EvaluateClientScopes(ctx, token, realm, idOfClient, userID, scope)
+	return res0, call.end(err), err
+}
+
+// EvaluatePermission calls GoKeycloak.EvaluatePermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) EvaluatePermission(ctx context.Context, userToken string, realm string, audience string, response_mode string, permissions []string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "EvaluatePermission", realm, opts)
+	_, res0, err := v.g.EvaluatePermission(ctx, userToken, realm, audience, response_mode, permissions)
+	return res0, call.end(err), err
+}
+
+// ExecuteActionsEmail calls GoKeycloak.ExecuteActionsEmail and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ExecuteActionsEmail(ctx context.Context, token string, realm string, params ExecuteActionsEmail, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ExecuteActionsEmail", realm, opts)
+	_, err := v.g.ExecuteActionsEmail(ctx, token, realm, params)
+	return call.end(err), err
+}
+
+// ExportIDPPublicBrokerConfig calls GoKeycloak.ExportIDPPublicBrokerConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ExportIDPPublicBrokerConfig(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ExportIDPPublicBrokerConfig", realm, opts)
+	_, res0, err := v.g.ExportIDPPublicBrokerConfig(ctx, token, realm, alias)
+	return res0, call.end(err), err
+}
+
+// GenerateAndDownloadClientKeyStore calls GoKeycloak.GenerateAndDownloadClientKeyStore and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateAndDownloadClientKeyStore(ctx context.Context, token string, realm string, idOfClient string, attr string, config ClientKeyStoreConfig, opts ...RequestOption) ([]byte, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateAndDownloadClientKeyStore", realm, opts)
+	_, res0, err := v.g.GenerateAndDownloadClientKeyStore(ctx, token, realm, idOfClient, attr, config)
+	return res0, call.end(err), err
+}
+
+// GenerateClientCertificate calls GoKeycloak.GenerateClientCertificate and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateClientCertificate(ctx context.Context, token string, realm string, idOfClient string, attr string, opts ...RequestOption) (*CertificateRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateClientCertificate", realm, opts)
+	_, res0, err := v.g.GenerateClientCertificate(ctx, token, realm, idOfClient, attr)
+	return res0, call.end(err), err
+}
+
+// GenerateClientInitialAccessToken calls GoKeycloak.GenerateClientInitialAccessToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateClientInitialAccessToken(ctx context.Context, realm string, adminAccessToken string, requestBody ClientInitialAccessTokenRequest, opts ...RequestOption) (ClientInitialAccessTokenResponse, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateClientInitialAccessToken", realm, opts)
+	_, res0, err := v.g.GenerateClientInitialAccessToken(ctx, realm, adminAccessToken, requestBody)
+	return res0, call.end(err), err
+}
+
+// GenerateExampleAccessToken calls GoKeycloak.GenerateExampleAccessToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateExampleAccessToken(ctx context.Context, token string, realm string, idOfClient string, userID string, scope string, opts ...RequestOption) (*ExampleToken, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateExampleAccessToken", realm, opts)
+	_, res0, err := v.g.GenerateExampleAccessToken(ctx, token, realm, idOfClient, userID, scope)
+	return res0, call.end(err), err
+}
+
+// GenerateExampleIDToken calls GoKeycloak.GenerateExampleIDToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateExampleIDToken(ctx context.Context, token string, realm string, idOfClient string, userID string, scope string, opts ...RequestOption) (*ExampleToken, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateExampleIDToken", realm, opts)
+	_, res0, err := v.g.GenerateExampleIDToken(ctx, token, realm, idOfClient, userID, scope)
+	return res0, call.end(err), err
+}
+
+// GenerateExampleUserInfo calls GoKeycloak.GenerateExampleUserInfo and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GenerateExampleUserInfo(ctx context.Context, token string, realm string, idOfClient string, userID string, scope string, opts ...RequestOption) (*ExampleToken, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GenerateExampleUserInfo", realm, opts)
+	_, res0, err := v.g.GenerateExampleUserInfo(ctx, token, realm, idOfClient, userID, scope)
+	return res0, call.end(err), err
+}
+
+// GetAdapterConfiguration calls GoKeycloak.GetAdapterConfiguration and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAdapterConfiguration(ctx context.Context, accessToken string, realm string, clientID string, opts ...RequestOption) (*AdapterConfiguration, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAdapterConfiguration", realm, opts)
+	_, res0, err := v.g.GetAdapterConfiguration(ctx, accessToken, realm, clientID)
+	return res0, call.end(err), err
+}
+
+// GetAdminEvents calls GoKeycloak.GetAdminEvents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAdminEvents(ctx context.Context, token string, realm string, params GetAdminEventsParams, opts ...RequestOption) ([]*AdminEventRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAdminEvents", realm, opts)
+	_, res0, err := v.g.GetAdminEvents(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetAllRealmsInfo calls GoKeycloak.GetAllRealmsInfo and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAllRealmsInfo(ctx context.Context, adminAccessToken string, opts ...RequestOption) ([]*ServerInfoRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAllRealmsInfo", "", opts)
+	_, res0, err := v.g.GetAllRealmsInfo(ctx, adminAccessToken)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticationExecutions calls GoKeycloak.GetAuthenticationExecutions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticationExecutions(ctx context.Context, token string, realm string, flow string, opts ...RequestOption) ([]*ModifyAuthenticationExecutionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticationExecutions", realm, opts)
+	_, res0, err := v.g.GetAuthenticationExecutions(ctx, token, realm, flow)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticationFlow calls GoKeycloak.GetAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticationFlow(ctx context.Context, token string, realm string, authenticationFlowID string, opts ...RequestOption) (*AuthenticationFlowRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticationFlow", realm, opts)
+	_, res0, err := v.g.GetAuthenticationFlow(ctx, token, realm, authenticationFlowID)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticationFlows calls GoKeycloak.GetAuthenticationFlows and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticationFlows(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*AuthenticationFlowRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticationFlows", realm, opts)
+	_, res0, err := v.g.GetAuthenticationFlows(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticatorConfig calls GoKeycloak.GetAuthenticatorConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticatorConfig(ctx context.Context, token string, realm string, configID string, opts ...RequestOption) (*AuthenticatorConfigRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticatorConfig", realm, opts)
+	_, res0, err := v.g.GetAuthenticatorConfig(ctx, token, realm, configID)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticatorConfigDescription calls GoKeycloak.GetAuthenticatorConfigDescription and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticatorConfigDescription(ctx context.Context, token string, realm string, providerID string, opts ...RequestOption) (*AuthenticatorConfigInfoRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticatorConfigDescription", realm, opts)
+	_, res0, err := v.g.GetAuthenticatorConfigDescription(ctx, token, realm, providerID)
+	return res0, call.end(err), err
+}
+
+// GetAuthenticatorProviders calls GoKeycloak.GetAuthenticatorProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthenticatorProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*AuthenticationProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthenticatorProviders", realm, opts)
+	_, res0, err := v.g.GetAuthenticatorProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetAuthorizationPolicyAssociatedPolicies calls GoKeycloak.GetAuthorizationPolicyAssociatedPolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthorizationPolicyAssociatedPolicies(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) ([]*PolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthorizationPolicyAssociatedPolicies", realm, opts)
+	res0, err := v.g.GetAuthorizationPolicyAssociatedPolicies(ctx, token, realm, idOfClient, policyID)
+	return res0, call.end(err), err
+}
+
+// GetAuthorizationPolicyResources calls GoKeycloak.GetAuthorizationPolicyResources and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthorizationPolicyResources(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) ([]*PolicyResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthorizationPolicyResources", realm, opts)
+	res0, err := v.g.GetAuthorizationPolicyResources(ctx, token, realm, idOfClient, policyID)
+	return res0, call.end(err), err
+}
+
+// GetAuthorizationPolicyScopes calls GoKeycloak.GetAuthorizationPolicyScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAuthorizationPolicyScopes(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) ([]*PolicyScopeRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAuthorizationPolicyScopes", realm, opts)
+	res0, err := v.g.GetAuthorizationPolicyScopes(ctx, token, realm, idOfClient, policyID)
+	return res0, call.end(err), err
+}
+
+// GetAvailableClientRolesByGroupID calls GoKeycloak.GetAvailableClientRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAvailableClientRolesByGroupID(ctx context.Context, token string, realm string, idOfClient string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAvailableClientRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetAvailableClientRolesByGroupID(ctx, token, realm, idOfClient, groupID)
+	return res0, call.end(err), err
+}
+
+// GetAvailableClientRolesByUserID calls GoKeycloak.GetAvailableClientRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAvailableClientRolesByUserID(ctx context.Context, token string, realm string, idOfClient string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAvailableClientRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetAvailableClientRolesByUserID(ctx, token, realm, idOfClient, userID)
+	return res0, call.end(err), err
+}
+
+// GetAvailableRealmRolesByGroupID calls GoKeycloak.GetAvailableRealmRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAvailableRealmRolesByGroupID(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAvailableRealmRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetAvailableRealmRolesByGroupID(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetAvailableRealmRolesByUserID calls GoKeycloak.GetAvailableRealmRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetAvailableRealmRolesByUserID(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetAvailableRealmRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetAvailableRealmRolesByUserID(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetCerts calls GoKeycloak.GetCerts and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCerts(ctx context.Context, realm string, opts ...RequestOption) (*CertResponse, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCerts", realm, opts)
+	_, res0, err := v.g.GetCerts(ctx, realm)
+	return res0, call.end(err), err
+}
+
+// GetClient calls GoKeycloak.GetClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClient(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*Client, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClient", realm, opts)
+	_, res0, err := v.g.GetClient(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientAuthenticatorProviders calls GoKeycloak.GetClientAuthenticatorProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientAuthenticatorProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*AuthenticationProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientAuthenticatorProviders", realm, opts)
+	_, res0, err := v.g.GetClientAuthenticatorProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetClientCertificate calls GoKeycloak.GetClientCertificate and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientCertificate(ctx context.Context, token string, realm string, idOfClient string, attr string, opts ...RequestOption) (*CertificateRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientCertificate", realm, opts)
+	_, res0, err := v.g.GetClientCertificate(ctx, token, realm, idOfClient, attr)
+	return res0, call.end(err), err
+}
+
+// GetClientInstallation calls GoKeycloak.GetClientInstallation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientInstallation(ctx context.Context, token string, realm string, idOfClient string, providerID string, opts ...RequestOption) (*ClientInstallation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientInstallation", realm, opts)
+	_, res0, err := v.g.GetClientInstallation(ctx, token, realm, idOfClient, providerID)
+	return res0, call.end(err), err
+}
+
+// GetClientManagementPermissions calls GoKeycloak.GetClientManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientManagementPermissions(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientManagementPermissions", realm, opts)
+	_, res0, err := v.g.GetClientManagementPermissions(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientOIDCInstallation calls GoKeycloak.GetClientOIDCInstallation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientOIDCInstallation(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*AdapterConfiguration, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientOIDCInstallation", realm, opts)
+	_, res0, err := v.g.GetClientOIDCInstallation(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientOfflineSessionCount calls GoKeycloak.GetClientOfflineSessionCount and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientOfflineSessionCount(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (int64, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientOfflineSessionCount", realm, opts)
+	_, res0, err := v.g.GetClientOfflineSessionCount(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientOfflineSessions calls GoKeycloak.GetClientOfflineSessions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientOfflineSessions(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*UserSessionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientOfflineSessions", realm, opts)
+	_, res0, err := v.g.GetClientOfflineSessions(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientPolicies calls GoKeycloak.GetClientPolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientPolicies(ctx context.Context, token string, realm string, includeGlobal bool, opts ...RequestOption) (*ClientPoliciesRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientPolicies", realm, opts)
+	_, res0, err := v.g.GetClientPolicies(ctx, token, realm, includeGlobal)
+	return res0, call.end(err), err
+}
+
+// GetClientProfiles calls GoKeycloak.GetClientProfiles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientProfiles(ctx context.Context, token string, realm string, includeGlobal bool, opts ...RequestOption) (*ClientProfilesRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientProfiles", realm, opts)
+	_, res0, err := v.g.GetClientProfiles(ctx, token, realm, includeGlobal)
+	return res0, call.end(err), err
+}
+
+// GetClientRepresentation calls GoKeycloak.GetClientRepresentation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRepresentation(ctx context.Context, accessToken string, realm string, clientID string, opts ...RequestOption) (*Client, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRepresentation", realm, opts)
+	_, res0, err := v.g.GetClientRepresentation(ctx, accessToken, realm, clientID)
+	return res0, call.end(err), err
+}
+
+// GetClientRole calls GoKeycloak.GetClientRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRole(ctx context.Context, token string, realm string, idOfClient string, roleName string, opts ...RequestOption) (*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRole", realm, opts)
+	_, res0, err := v.g.GetClientRole(ctx, token, realm, idOfClient, roleName)
+	return res0, call.end(err), err
+}
+
+// GetClientRoleByID calls GoKeycloak.GetClientRoleByID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRoleByID(ctx context.Context, token string, realm string, roleID string, opts ...RequestOption) (*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRoleByID", realm, opts)
+	_, res0, err := v.g.GetClientRoleByID(ctx, token, realm, roleID)
+	return res0, call.end(err), err
+}
+
+// GetClientRoles calls GoKeycloak.GetClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRoles(ctx context.Context, token string, realm string, idOfClient string, params GetRoleParams, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRoles", realm, opts)
+	_, res0, err := v.g.GetClientRoles(ctx, token, realm, idOfClient, params)
+	return res0, call.end(err), err
+}
+
+// GetClientRolesByGroupID calls GoKeycloak.GetClientRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRolesByGroupID(ctx context.Context, token string, realm string, idOfClient string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetClientRolesByGroupID(ctx, token, realm, idOfClient, groupID)
+	return res0, call.end(err), err
+}
+
+// GetClientRolesByUserID calls GoKeycloak.GetClientRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientRolesByUserID(ctx context.Context, token string, realm string, idOfClient string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetClientRolesByUserID(ctx, token, realm, idOfClient, userID)
+	return res0, call.end(err), err
+}
+
+// GetClientSAMLDescriptor calls GoKeycloak.GetClientSAMLDescriptor and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientSAMLDescriptor(ctx context.Context, token string, realm string, idOfClient string, providerID string, opts ...RequestOption) (*SAMLEntityDescriptor, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientSAMLDescriptor", realm, opts)
+	_, res0, err := v.g.GetClientSAMLDescriptor(ctx, token, realm, idOfClient, providerID)
+	return res0, call.end(err), err
+}
+
+// GetClientScope calls GoKeycloak.GetClientScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScope(ctx context.Context, token string, realm string, scopeID string, opts ...RequestOption) (*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScope", realm, opts)
+	_, res0, err := v.g.GetClientScope(ctx, token, realm, scopeID)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeMappings calls GoKeycloak.GetClientScopeMappings and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeMappings(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*MappingsRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeMappings", realm, opts)
+	_, res0, err := v.g.GetClientScopeMappings(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeMappingsClientRoles calls GoKeycloak.GetClientScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClient string, idOfSelectedClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeMappingsClientRoles", realm, opts)
+	_, res0, err := v.g.GetClientScopeMappingsClientRoles(ctx, token, realm, idOfClient, idOfSelectedClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeMappingsClientRolesAvailable calls GoKeycloak.GetClientScopeMappingsClientRolesAvailable and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeMappingsClientRolesAvailable(ctx context.Context, token string, realm string, idOfClient string, idOfSelectedClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeMappingsClientRolesAvailable", realm, opts)
+	_, res0, err := v.g.GetClientScopeMappingsClientRolesAvailable(ctx, token, realm, idOfClient, idOfSelectedClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeMappingsRealmRoles calls GoKeycloak.GetClientScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeMappingsRealmRoles(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeMappingsRealmRoles", realm, opts)
+	_, res0, err := v.g.GetClientScopeMappingsRealmRoles(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeMappingsRealmRolesAvailable calls GoKeycloak.GetClientScopeMappingsRealmRolesAvailable and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeMappingsRealmRolesAvailable(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeMappingsRealmRolesAvailable", realm, opts)
+	_, res0, err := v.g.GetClientScopeMappingsRealmRolesAvailable(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeProtocolMapper calls GoKeycloak.GetClientScopeProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeProtocolMapper(ctx context.Context, token string, realm string, scopeID string, protocolMapperID string, opts ...RequestOption) (*ProtocolMappers, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeProtocolMapper", realm, opts)
+	_, res0, err := v.g.GetClientScopeProtocolMapper(ctx, token, realm, scopeID, protocolMapperID)
+	return res0, call.end(err), err
+}
+
+// GetClientScopeProtocolMappers calls GoKeycloak.GetClientScopeProtocolMappers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopeProtocolMappers(ctx context.Context, token string, realm string, scopeID string, opts ...RequestOption) ([]*ProtocolMappers, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopeProtocolMappers", realm, opts)
+	_, res0, err := v.g.GetClientScopeProtocolMappers(ctx, token, realm, scopeID)
+	return res0, call.end(err), err
+}
+
+// GetClientScopes calls GoKeycloak.GetClientScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopes(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopes", realm, opts)
+	_, res0, err := v.g.GetClientScopes(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetClientScopesScopeMappingsClientRoles calls GoKeycloak.GetClientScopesScopeMappingsClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopesScopeMappingsClientRoles(ctx context.Context, token string, realm string, idOfClientScope string, idOfClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopesScopeMappingsClientRoles", realm, opts)
+	_, res0, err := v.g.GetClientScopesScopeMappingsClientRoles(ctx, token, realm, idOfClientScope, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopesScopeMappingsClientRolesAvailable calls GoKeycloak.GetClientScopesScopeMappingsClientRolesAvailable and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopesScopeMappingsClientRolesAvailable(ctx context.Context, token string, realm string, idOfClientScope string, idOfClient string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopesScopeMappingsClientRolesAvailable", realm, opts)
+	_, res0, err := v.g.GetClientScopesScopeMappingsClientRolesAvailable(ctx, token, realm, idOfClientScope, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientScopesScopeMappingsRealmRoles calls GoKeycloak.GetClientScopesScopeMappingsRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopesScopeMappingsRealmRoles(ctx context.Context, token string, realm string, clientScopeID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopesScopeMappingsRealmRoles", realm, opts)
+	_, res0, err := v.g.GetClientScopesScopeMappingsRealmRoles(ctx, token, realm, clientScopeID)
+	return res0, call.end(err), err
+}
+
+// GetClientScopesScopeMappingsRealmRolesAvailable calls GoKeycloak.GetClientScopesScopeMappingsRealmRolesAvailable and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientScopesScopeMappingsRealmRolesAvailable(ctx context.Context, token string, realm string, clientScopeID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientScopesScopeMappingsRealmRolesAvailable", realm, opts)
+	_, res0, err := v.g.GetClientScopesScopeMappingsRealmRolesAvailable(ctx, token, realm, clientScopeID)
+	return res0, call.end(err), err
+}
+
+// GetClientSecret calls GoKeycloak.GetClientSecret and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientSecret(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*CredentialRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientSecret", realm, opts)
+	_, res0, err := v.g.GetClientSecret(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientServiceAccount calls GoKeycloak.GetClientServiceAccount and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientServiceAccount(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientServiceAccount", realm, opts)
+	_, res0, err := v.g.GetClientServiceAccount(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientSessionCount calls GoKeycloak.GetClientSessionCount and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientSessionCount(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (int64, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientSessionCount", realm, opts)
+	_, res0, err := v.g.GetClientSessionCount(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientSessionStats calls GoKeycloak.GetClientSessionStats and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientSessionStats(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*ClientSessionStats, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientSessionStats", realm, opts)
+	_, res0, err := v.g.GetClientSessionStats(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetClientUserSessions calls GoKeycloak.GetClientUserSessions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientUserSessions(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*UserSessionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientUserSessions", realm, opts)
+	_, res0, err := v.g.GetClientUserSessions(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClients calls GoKeycloak.GetClients and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClients(ctx context.Context, token string, realm string, params GetClientsParams, opts ...RequestOption) ([]*Client, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClients", realm, opts)
+	_, res0, err := v.g.GetClients(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetClientsDefaultScopes calls GoKeycloak.GetClientsDefaultScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientsDefaultScopes(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientsDefaultScopes", realm, opts)
+	_, res0, err := v.g.GetClientsDefaultScopes(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetClientsOptionalScopes calls GoKeycloak.GetClientsOptionalScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetClientsOptionalScopes(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) ([]*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetClientsOptionalScopes", realm, opts)
+	_, res0, err := v.g.GetClientsOptionalScopes(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetComponent calls GoKeycloak.GetComponent and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetComponent(ctx context.Context, token string, realm string, componentID string, opts ...RequestOption) (*Component, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetComponent", realm, opts)
+	res0, err := v.g.GetComponent(ctx, token, realm, componentID)
+	return res0, call.end(err), err
+}
+
+// GetComponents calls GoKeycloak.GetComponents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetComponents(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*Component, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetComponents", realm, opts)
+	res0, err := v.g.GetComponents(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetComponentsWithParams calls GoKeycloak.GetComponentsWithParams and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetComponentsWithParams(ctx context.Context, token string, realm string, params GetComponentsParams, opts ...RequestOption) ([]*Component, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetComponentsWithParams", realm, opts)
+	res0, err := v.g.GetComponentsWithParams(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetCompositeClientRolesByGroupID calls GoKeycloak.GetCompositeClientRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeClientRolesByGroupID(ctx context.Context, token string, realm string, idOfClient string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeClientRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetCompositeClientRolesByGroupID(ctx, token, realm, idOfClient, groupID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeClientRolesByRoleID calls GoKeycloak.GetCompositeClientRolesByRoleID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeClientRolesByRoleID(ctx context.Context, token string, realm string, idOfClient string, roleID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeClientRolesByRoleID", realm, opts)
+	_, res0, err := v.g.GetCompositeClientRolesByRoleID(ctx, token, realm, idOfClient, roleID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeClientRolesByUserID calls GoKeycloak.GetCompositeClientRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeClientRolesByUserID(ctx context.Context, token string, realm string, idOfClient string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeClientRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetCompositeClientRolesByUserID(ctx, token, realm, idOfClient, userID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeRealmRoles calls GoKeycloak.GetCompositeRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeRealmRoles(ctx context.Context, token string, realm string, roleName string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeRealmRoles", realm, opts)
+	_, res0, err := v.g.GetCompositeRealmRoles(ctx, token, realm, roleName)
+	return res0, call.end(err), err
+}
+
+// GetCompositeRealmRolesByGroupID calls GoKeycloak.GetCompositeRealmRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeRealmRolesByGroupID(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeRealmRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetCompositeRealmRolesByGroupID(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeRealmRolesByRoleID calls GoKeycloak.GetCompositeRealmRolesByRoleID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeRealmRolesByRoleID(ctx context.Context, token string, realm string, roleID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeRealmRolesByRoleID", realm, opts)
+	_, res0, err := v.g.GetCompositeRealmRolesByRoleID(ctx, token, realm, roleID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeRealmRolesByUserID calls GoKeycloak.GetCompositeRealmRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeRealmRolesByUserID(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeRealmRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetCompositeRealmRolesByUserID(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetCompositeRolesByRoleID calls GoKeycloak.GetCompositeRolesByRoleID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCompositeRolesByRoleID(ctx context.Context, token string, realm string, roleID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCompositeRolesByRoleID", realm, opts)
+	_, res0, err := v.g.GetCompositeRolesByRoleID(ctx, token, realm, roleID)
+	return res0, call.end(err), err
+}
+
+// GetConfiguredUserStorageCredentialTypes calls GoKeycloak.GetConfiguredUserStorageCredentialTypes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetConfiguredUserStorageCredentialTypes(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetConfiguredUserStorageCredentialTypes", realm, opts)
+	res0, err := v.g.GetConfiguredUserStorageCredentialTypes(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetCredentialRegistrators calls GoKeycloak.GetCredentialRegistrators and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCredentialRegistrators(ctx context.Context, token string, realm string, opts ...RequestOption) ([]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCredentialRegistrators", realm, opts)
+	res0, err := v.g.GetCredentialRegistrators(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetCredentials calls GoKeycloak.GetCredentials and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetCredentials(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*CredentialRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetCredentials", realm, opts)
+	res0, err := v.g.GetCredentials(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetDefaultDefaultClientScopes calls GoKeycloak.GetDefaultDefaultClientScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetDefaultDefaultClientScopes(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetDefaultDefaultClientScopes", realm, opts)
+	_, res0, err := v.g.GetDefaultDefaultClientScopes(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetDefaultGroups calls GoKeycloak.GetDefaultGroups and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetDefaultGroups(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetDefaultGroups", realm, opts)
+	res0, err := v.g.GetDefaultGroups(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetDefaultOptionalClientScopes calls GoKeycloak.GetDefaultOptionalClientScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetDefaultOptionalClientScopes(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*ClientScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetDefaultOptionalClientScopes", realm, opts)
+	_, res0, err := v.g.GetDefaultOptionalClientScopes(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetDependentPermissions calls GoKeycloak.GetDependentPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetDependentPermissions(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) ([]*PermissionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetDependentPermissions", realm, opts)
+	res0, err := v.g.GetDependentPermissions(ctx, token, realm, idOfClient, policyID)
+	return res0, call.end(err), err
+}
+
+// GetEvaluatedGrantedClientRoles calls GoKeycloak.GetEvaluatedGrantedClientRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetEvaluatedGrantedClientRoles(ctx context.Context, token string, realm string, idOfClient string, idOfRoleClient string, scope string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetEvaluatedGrantedClientRoles", realm, opts)
+	_, res0, err := v.g.GetEvaluatedGrantedClientRoles(ctx, token, realm, idOfClient, idOfRoleClient, scope)
+	return res0, call.end(err), err
+}
+
+// GetEvaluatedGrantedRealmRoles calls GoKeycloak.GetEvaluatedGrantedRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetEvaluatedGrantedRealmRoles(ctx context.Context, token string, realm string, idOfClient string, scope string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetEvaluatedGrantedRealmRoles", realm, opts)
+	_, res0, err := v.g.GetEvaluatedGrantedRealmRoles(ctx, token, realm, idOfClient, scope)
+	return res0, call.end(err), err
+}
+
+// GetEvaluatedProtocolMappers calls GoKeycloak.GetEvaluatedProtocolMappers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetEvaluatedProtocolMappers(ctx context.Context, token string, realm string, idOfClient string, scope string, opts ...RequestOption) ([]*ProtocolMapperEvaluationRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetEvaluatedProtocolMappers", realm, opts)
+	_, res0, err := v.g.GetEvaluatedProtocolMappers(ctx, token, realm, idOfClient, scope)
+	return res0, call.end(err), err
+}
+
+// GetEvents calls GoKeycloak.GetEvents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetEvents(ctx context.Context, token string, realm string, params GetEventsParams, opts ...RequestOption) ([]*EventRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetEvents", realm, opts)
+	res0, err := v.g.GetEvents(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetEventsConfig calls GoKeycloak.GetEventsConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetEventsConfig(ctx context.Context, token string, realm string, opts ...RequestOption) (*RealmEventsConfigRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetEventsConfig", realm, opts)
+	_, res0, err := v.g.GetEventsConfig(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetFormActionProviders calls GoKeycloak.GetFormActionProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetFormActionProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*AuthenticationProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetFormActionProviders", realm, opts)
+	_, res0, err := v.g.GetFormActionProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetFormProviders calls GoKeycloak.GetFormProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetFormProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*AuthenticationProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetFormProviders", realm, opts)
+	_, res0, err := v.g.GetFormProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetFullRealm calls GoKeycloak.GetFullRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetFullRealm(ctx context.Context, token string, realm string, opts ...RequestOption) (*RealmRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetFullRealm", realm, opts)
+	res0, err := v.g.GetFullRealm(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetGroup calls GoKeycloak.GetGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroup(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroup", realm, opts)
+	_, res0, err := v.g.GetGroup(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetGroupByPath calls GoKeycloak.GetGroupByPath and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupByPath(ctx context.Context, token string, realm string, groupPath string, opts ...RequestOption) (*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupByPath", realm, opts)
+	_, res0, err := v.g.GetGroupByPath(ctx, token, realm, groupPath)
+	return res0, call.end(err), err
+}
+
+// GetGroupManagementPermissions calls GoKeycloak.GetGroupManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupManagementPermissions(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupManagementPermissions", realm, opts)
+	_, res0, err := v.g.GetGroupManagementPermissions(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetGroupMembers calls GoKeycloak.GetGroupMembers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupMembers(ctx context.Context, token string, realm string, groupID string, params GetGroupsParams, opts ...RequestOption) ([]*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupMembers", realm, opts)
+	_, res0, err := v.g.GetGroupMembers(ctx, token, realm, groupID, params)
+	return res0, call.end(err), err
+}
+
+// GetGroups calls GoKeycloak.GetGroups and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroups(ctx context.Context, token string, realm string, params GetGroupsParams, opts ...RequestOption) ([]*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroups", realm, opts)
+	_, res0, err := v.g.GetGroups(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetGroupsByClientRole calls GoKeycloak.GetGroupsByClientRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupsByClientRole(ctx context.Context, token string, realm string, roleName string, clientID string, opts ...RequestOption) ([]*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupsByClientRole", realm, opts)
+	_, res0, err := v.g.GetGroupsByClientRole(ctx, token, realm, roleName, clientID)
+	return res0, call.end(err), err
+}
+
+// GetGroupsByRole calls GoKeycloak.GetGroupsByRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupsByRole(ctx context.Context, token string, realm string, roleName string, opts ...RequestOption) ([]*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupsByRole", realm, opts)
+	_, res0, err := v.g.GetGroupsByRole(ctx, token, realm, roleName)
+	return res0, call.end(err), err
+}
+
+// GetGroupsCount calls GoKeycloak.GetGroupsCount and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetGroupsCount(ctx context.Context, token string, realm string, params GetGroupsParams, opts ...RequestOption) (int, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetGroupsCount", realm, opts)
+	_, res0, err := v.g.GetGroupsCount(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProvider calls GoKeycloak.GetIdentityProvider and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProvider(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*IdentityProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProvider", realm, opts)
+	_, res0, err := v.g.GetIdentityProvider(ctx, token, realm, alias)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProviderManagementPermissions calls GoKeycloak.GetIdentityProviderManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProviderManagementPermissions(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProviderManagementPermissions", realm, opts)
+	_, res0, err := v.g.GetIdentityProviderManagementPermissions(ctx, token, realm, alias)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProviderMapper calls GoKeycloak.GetIdentityProviderMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProviderMapper(ctx context.Context, token string, realm string, alias string, mapperID string, opts ...RequestOption) (*IdentityProviderMapper, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProviderMapper", realm, opts)
+	_, res0, err := v.g.GetIdentityProviderMapper(ctx, token, realm, alias, mapperID)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProviderMapperByID calls GoKeycloak.GetIdentityProviderMapperByID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProviderMapperByID(ctx context.Context, token string, realm string, alias string, mapperID string, opts ...RequestOption) (*IdentityProviderMapper, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProviderMapperByID", realm, opts)
+	_, res0, err := v.g.GetIdentityProviderMapperByID(ctx, token, realm, alias, mapperID)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProviderMappers calls GoKeycloak.GetIdentityProviderMappers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProviderMappers(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) ([]*IdentityProviderMapper, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProviderMappers", realm, opts)
+	_, res0, err := v.g.GetIdentityProviderMappers(ctx, token, realm, alias)
+	return res0, call.end(err), err
+}
+
+// GetIdentityProviders calls GoKeycloak.GetIdentityProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIdentityProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*IdentityProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIdentityProviders", realm, opts)
+	_, res0, err := v.g.GetIdentityProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetIssuer calls GoKeycloak.GetIssuer and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetIssuer(ctx context.Context, realm string, opts ...RequestOption) (*IssuerResponse, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetIssuer", realm, opts)
+	_, res0, err := v.g.GetIssuer(ctx, realm)
+	return res0, call.end(err), err
+}
+
+// GetKeyProviders calls GoKeycloak.GetKeyProviders and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetKeyProviders(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*Component, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetKeyProviders", realm, opts)
+	_, res0, err := v.g.GetKeyProviders(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetKeyStoreConfig calls GoKeycloak.GetKeyStoreConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetKeyStoreConfig(ctx context.Context, token string, realm string, opts ...RequestOption) (*KeyStoreConfig, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetKeyStoreConfig", realm, opts)
+	_, res0, err := v.g.GetKeyStoreConfig(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetKeysByAlgorithm calls GoKeycloak.GetKeysByAlgorithm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetKeysByAlgorithm(ctx context.Context, token string, realm string, opts ...RequestOption) ([]AlgorithmKeys, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetKeysByAlgorithm", realm, opts)
+	res0, err := v.g.GetKeysByAlgorithm(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetLDAPMappers calls GoKeycloak.GetLDAPMappers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetLDAPMappers(ctx context.Context, token string, realm string, ldapID string, opts ...RequestOption) ([]*Component, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetLDAPMappers", realm, opts)
+	_, res0, err := v.g.GetLDAPMappers(ctx, token, realm, ldapID)
+	return res0, call.end(err), err
+}
+
+// GetLockedUsers calls GoKeycloak.GetLockedUsers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetLockedUsers(ctx context.Context, accessToken string, realm string, params GetUsersParams, maxPages int, opts ...RequestOption) ([]*LockedUser, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetLockedUsers", realm, opts)
+	_, res0, err := v.g.GetLockedUsers(ctx, accessToken, realm, params, maxPages)
+	return res0, call.end(err), err
+}
+
+// GetPermission calls GoKeycloak.GetPermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPermission(ctx context.Context, token string, realm string, idOfClient string, permissionID string, opts ...RequestOption) (*PermissionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPermission", realm, opts)
+	res0, err := v.g.GetPermission(ctx, token, realm, idOfClient, permissionID)
+	return res0, call.end(err), err
+}
+
+// GetPermissionResources calls GoKeycloak.GetPermissionResources and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPermissionResources(ctx context.Context, token string, realm string, idOfClient string, permissionID string, opts ...RequestOption) ([]*PermissionResource, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPermissionResources", realm, opts)
+	res0, err := v.g.GetPermissionResources(ctx, token, realm, idOfClient, permissionID)
+	return res0, call.end(err), err
+}
+
+// GetPermissionScopes calls GoKeycloak.GetPermissionScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPermissionScopes(ctx context.Context, token string, realm string, idOfClient string, permissionID string, opts ...RequestOption) ([]*PermissionScope, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPermissionScopes", realm, opts)
+	res0, err := v.g.GetPermissionScopes(ctx, token, realm, idOfClient, permissionID)
+	return res0, call.end(err), err
+}
+
+// GetPermissions calls GoKeycloak.GetPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPermissions(ctx context.Context, token string, realm string, idOfClient string, params GetPermissionParams, opts ...RequestOption) ([]*PermissionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPermissions", realm, opts)
+	res0, err := v.g.GetPermissions(ctx, token, realm, idOfClient, params)
+	return res0, call.end(err), err
+}
+
+// GetPolicies calls GoKeycloak.GetPolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPolicies(ctx context.Context, token string, realm string, idOfClient string, params GetPolicyParams, opts ...RequestOption) ([]*PolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPolicies", realm, opts)
+	res0, err := v.g.GetPolicies(ctx, token, realm, idOfClient, params)
+	return res0, call.end(err), err
+}
+
+// GetPolicy calls GoKeycloak.GetPolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetPolicy(ctx context.Context, token string, realm string, idOfClient string, policyID string, opts ...RequestOption) (*PolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetPolicy", realm, opts)
+	res0, err := v.g.GetPolicy(ctx, token, realm, idOfClient, policyID)
+	return res0, call.end(err), err
+}
+
+// GetRawUserInfo calls GoKeycloak.GetRawUserInfo and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRawUserInfo(ctx context.Context, accessToken string, realm string, opts ...RequestOption) (map[string]interface{}, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRawUserInfo", realm, opts)
+	_, res0, err := v.g.GetRawUserInfo(ctx, accessToken, realm)
+	return res0, call.end(err), err
+}
+
+// GetRealm calls GoKeycloak.GetRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealm(ctx context.Context, token string, realm string, opts ...RequestOption) (*RealmRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealm", realm, opts)
+	_, res0, err := v.g.GetRealm(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetRealmLocales calls GoKeycloak.GetRealmLocales and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmLocales(ctx context.Context, token string, realm string, opts ...RequestOption) ([]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmLocales", realm, opts)
+	_, res0, err := v.g.GetRealmLocales(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetRealmLocalizationText calls GoKeycloak.GetRealmLocalizationText and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmLocalizationText(ctx context.Context, token string, realm string, locale string, key string, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmLocalizationText", realm, opts)
+	_, res0, err := v.g.GetRealmLocalizationText(ctx, token, realm, locale, key)
+	return res0, call.end(err), err
+}
+
+// GetRealmLocalizationTexts calls GoKeycloak.GetRealmLocalizationTexts and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmLocalizationTexts(ctx context.Context, token string, realm string, locale string, opts ...RequestOption) (map[string]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmLocalizationTexts", realm, opts)
+	_, res0, err := v.g.GetRealmLocalizationTexts(ctx, token, realm, locale)
+	return res0, call.end(err), err
+}
+
+// GetRealmManagementClientID calls GoKeycloak.GetRealmManagementClientID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmManagementClientID(ctx context.Context, token string, realm string, opts ...RequestOption) (string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmManagementClientID", realm, opts)
+	res0, err := v.g.GetRealmManagementClientID(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetRealmRole calls GoKeycloak.GetRealmRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmRole(ctx context.Context, token string, realm string, roleName string, opts ...RequestOption) (*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmRole", realm, opts)
+	_, res0, err := v.g.GetRealmRole(ctx, token, realm, roleName)
+	return res0, call.end(err), err
+}
+
+// GetRealmRoleByID calls GoKeycloak.GetRealmRoleByID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmRoleByID(ctx context.Context, token string, realm string, roleID string, opts ...RequestOption) (*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmRoleByID", realm, opts)
+	_, res0, err := v.g.GetRealmRoleByID(ctx, token, realm, roleID)
+	return res0, call.end(err), err
+}
+
+// GetRealmRoles calls GoKeycloak.GetRealmRoles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmRoles(ctx context.Context, token string, realm string, params GetRoleParams, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmRoles", realm, opts)
+	_, res0, err := v.g.GetRealmRoles(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetRealmRolesByGroupID calls GoKeycloak.GetRealmRolesByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmRolesByGroupID(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmRolesByGroupID", realm, opts)
+	_, res0, err := v.g.GetRealmRolesByGroupID(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetRealmRolesByUserID calls GoKeycloak.GetRealmRolesByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmRolesByUserID(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*Role, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmRolesByUserID", realm, opts)
+	_, res0, err := v.g.GetRealmRolesByUserID(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetRealmState calls GoKeycloak.GetRealmState and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealmState(ctx context.Context, token string, realm string, opts ...RequestOption) (*RealmState, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealmState", realm, opts)
+	res0, err := v.g.GetRealmState(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetRealms calls GoKeycloak.GetRealms and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRealms(ctx context.Context, token string, opts ...RequestOption) ([]*RealmRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRealms", "", opts)
+	_, res0, err := v.g.GetRealms(ctx, token)
+	return res0, call.end(err), err
+}
+
+// GetRequestingPartyPermissionDecision calls GoKeycloak.GetRequestingPartyPermissionDecision and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRequestingPartyPermissionDecision(ctx context.Context, token string, realm string, options RequestingPartyTokenOptions, opts ...RequestOption) (*RequestingPartyPermissionDecision, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRequestingPartyPermissionDecision", realm, opts)
+	_, res0, err := v.g.GetRequestingPartyPermissionDecision(ctx, token, realm, options)
+	return res0, call.end(err), err
+}
+
+// GetRequestingPartyPermissions calls GoKeycloak.GetRequestingPartyPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRequestingPartyPermissions(ctx context.Context, token string, realm string, options RequestingPartyTokenOptions, opts ...RequestOption) (*[]RequestingPartyPermission, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRequestingPartyPermissions", realm, opts)
+	_, res0, err := v.g.GetRequestingPartyPermissions(ctx, token, realm, options)
+	return res0, call.end(err), err
+}
+
+// GetRequestingPartyToken calls GoKeycloak.GetRequestingPartyToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRequestingPartyToken(ctx context.Context, token string, realm string, options RequestingPartyTokenOptions, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRequestingPartyToken", realm, opts)
+	_, res0, err := v.g.GetRequestingPartyToken(ctx, token, realm, options)
+	return res0, call.end(err), err
+}
+
+// GetRequiredAction calls GoKeycloak.GetRequiredAction and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRequiredAction(ctx context.Context, token string, realm string, alias string, opts ...RequestOption) (*RequiredActionProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRequiredAction", realm, opts)
+	_, res0, err := v.g.GetRequiredAction(ctx, token, realm, alias)
+	return res0, call.end(err), err
+}
+
+// GetRequiredActions calls GoKeycloak.GetRequiredActions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRequiredActions(ctx context.Context, token string, realm string, opts ...RequestOption) ([]*RequiredActionProviderRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRequiredActions", realm, opts)
+	_, res0, err := v.g.GetRequiredActions(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetResource calls GoKeycloak.GetResource and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResource(ctx context.Context, token string, realm string, idOfClient string, resourceID string, opts ...RequestOption) (*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResource", realm, opts)
+	_, res0, err := v.g.GetResource(ctx, token, realm, idOfClient, resourceID)
+	return res0, call.end(err), err
+}
+
+// GetResourceClient calls GoKeycloak.GetResourceClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResourceClient(ctx context.Context, token string, realm string, resourceID string, opts ...RequestOption) (*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResourceClient", realm, opts)
+	_, res0, err := v.g.GetResourceClient(ctx, token, realm, resourceID)
+	return res0, call.end(err), err
+}
+
+// GetResourcePolicies calls GoKeycloak.GetResourcePolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResourcePolicies(ctx context.Context, token string, realm string, params GetResourcePoliciesParams, opts ...RequestOption) ([]*ResourcePolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResourcePolicies", realm, opts)
+	res0, err := v.g.GetResourcePolicies(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetResourcePolicy calls GoKeycloak.GetResourcePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResourcePolicy(ctx context.Context, token string, realm string, permissionID string, opts ...RequestOption) (*ResourcePolicyRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResourcePolicy", realm, opts)
+	res0, err := v.g.GetResourcePolicy(ctx, token, realm, permissionID)
+	return res0, call.end(err), err
+}
+
+// GetResources calls GoKeycloak.GetResources and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResources(ctx context.Context, token string, realm string, idOfClient string, params GetResourceParams, opts ...RequestOption) ([]*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResources", realm, opts)
+	_, res0, err := v.g.GetResources(ctx, token, realm, idOfClient, params)
+	return res0, call.end(err), err
+}
+
+// GetResourcesClient calls GoKeycloak.GetResourcesClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetResourcesClient(ctx context.Context, token string, realm string, params GetResourceParams, opts ...RequestOption) ([]*ResourceRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetResourcesClient", realm, opts)
+	_, res0, err := v.g.GetResourcesClient(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetRoleManagementPermissions calls GoKeycloak.GetRoleManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRoleManagementPermissions(ctx context.Context, token string, realm string, roleID string, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRoleManagementPermissions", realm, opts)
+	_, res0, err := v.g.GetRoleManagementPermissions(ctx, token, realm, roleID)
+	return res0, call.end(err), err
+}
+
+// GetRoleMappingByGroupID calls GoKeycloak.GetRoleMappingByGroupID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRoleMappingByGroupID(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*MappingsRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRoleMappingByGroupID", realm, opts)
+	_, res0, err := v.g.GetRoleMappingByGroupID(ctx, token, realm, groupID)
+	return res0, call.end(err), err
+}
+
+// GetRoleMappingByUserID calls GoKeycloak.GetRoleMappingByUserID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetRoleMappingByUserID(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) (*MappingsRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetRoleMappingByUserID", realm, opts)
+	_, res0, err := v.g.GetRoleMappingByUserID(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetScope calls GoKeycloak.GetScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetScope(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*ScopeRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetScope", realm, opts)
+	_, res0, err := v.g.GetScope(ctx, token, realm, idOfClient, scopeID)
+	return res0, call.end(err), err
+}
+
+// GetScopes calls GoKeycloak.GetScopes and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetScopes(ctx context.Context, token string, realm string, idOfClient string, params GetScopeParams, opts ...RequestOption) ([]*ScopeRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetScopes", realm, opts)
+	_, res0, err := v.g.GetScopes(ctx, token, realm, idOfClient, params)
+	return res0, call.end(err), err
+}
+
+// GetSessionInventory calls GoKeycloak.GetSessionInventory and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetSessionInventory(ctx context.Context, token string, realm string, options SessionInventoryOptions, opts ...RequestOption) (*SessionInventory, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetSessionInventory", realm, opts)
+	res0, err := v.g.GetSessionInventory(ctx, token, realm, options)
+	return res0, call.end(err), err
+}
+
+// GetToken calls GoKeycloak.GetToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetToken(ctx context.Context, realm string, options TokenOptions, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetToken", realm, opts)
+	_, res0, err := v.g.GetToken(ctx, realm, options)
+	return res0, call.end(err), err
+}
+
+// GetUserBruteForceDetectionStatus calls GoKeycloak.GetUserBruteForceDetectionStatus and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserBruteForceDetectionStatus(ctx context.Context, accessToken string, realm string, userID string, opts ...RequestOption) (*BruteForceStatus, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserBruteForceDetectionStatus", realm, opts)
+	res0, err := v.g.GetUserBruteForceDetectionStatus(ctx, accessToken, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetUserByID calls GoKeycloak.GetUserByID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserByID(ctx context.Context, accessToken string, realm string, userID string, opts ...RequestOption) (*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserByID", realm, opts)
+	_, res0, err := v.g.GetUserByID(ctx, accessToken, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetUserConsents calls GoKeycloak.GetUserConsents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserConsents(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*UserConsentRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserConsents", realm, opts)
+	_, res0, err := v.g.GetUserConsents(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetUserCount calls GoKeycloak.GetUserCount and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserCount(ctx context.Context, token string, realm string, params GetUsersParams, opts ...RequestOption) (int, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserCount", realm, opts)
+	_, res0, err := v.g.GetUserCount(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetUserFederatedIdentities calls GoKeycloak.GetUserFederatedIdentities and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserFederatedIdentities(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*FederatedIdentityRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserFederatedIdentities", realm, opts)
+	_, res0, err := v.g.GetUserFederatedIdentities(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetUserGroups calls GoKeycloak.GetUserGroups and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserGroups(ctx context.Context, token string, realm string, userID string, params GetGroupsParams, opts ...RequestOption) ([]*Group, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserGroups", realm, opts)
+	_, res0, err := v.g.GetUserGroups(ctx, token, realm, userID, params)
+	return res0, call.end(err), err
+}
+
+// GetUserInfo calls GoKeycloak.GetUserInfo and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserInfo(ctx context.Context, accessToken string, realm string, opts ...RequestOption) (*UserInfo, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserInfo", realm, opts)
+	_, res0, err := v.g.GetUserInfo(ctx, accessToken, realm)
+	return res0, call.end(err), err
+}
+
+// GetUserOfflineSessionsForClient calls GoKeycloak.GetUserOfflineSessionsForClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserOfflineSessionsForClient(ctx context.Context, token string, realm string, userID string, idOfClient string, opts ...RequestOption) ([]*UserSessionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserOfflineSessionsForClient", realm, opts)
+	_, res0, err := v.g.GetUserOfflineSessionsForClient(ctx, token, realm, userID, idOfClient)
+	return res0, call.end(err), err
+}
+
+// GetUserPermissions calls GoKeycloak.GetUserPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserPermissions(ctx context.Context, token string, realm string, params GetUserPermissionParams, opts ...RequestOption) ([]*PermissionGrantResponseRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserPermissions", realm, opts)
+	res0, err := v.g.GetUserPermissions(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetUserProfile calls GoKeycloak.GetUserProfile and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserProfile(ctx context.Context, token string, realm string, opts ...RequestOption) (*UserProfileConfig, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserProfile", realm, opts)
+	_, res0, err := v.g.GetUserProfile(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetUserProfileMetadata calls GoKeycloak.GetUserProfileMetadata and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserProfileMetadata(ctx context.Context, token string, realm string, opts ...RequestOption) (*UserProfileMetadata, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserProfileMetadata", realm, opts)
+	_, res0, err := v.g.GetUserProfileMetadata(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GetUserSessions calls GoKeycloak.GetUserSessions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUserSessions(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]*UserSessionRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUserSessions", realm, opts)
+	_, res0, err := v.g.GetUserSessions(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// GetUsers calls GoKeycloak.GetUsers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUsers(ctx context.Context, token string, realm string, params GetUsersParams, opts ...RequestOption) ([]*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUsers", realm, opts)
+	_, res0, err := v.g.GetUsers(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// GetUsersByClientRoleName calls GoKeycloak.GetUsersByClientRoleName and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUsersByClientRoleName(ctx context.Context, token string, realm string, idOfClient string, roleName string, params GetUsersByRoleParams, opts ...RequestOption) ([]*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUsersByClientRoleName", realm, opts)
+	_, res0, err := v.g.GetUsersByClientRoleName(ctx, token, realm, idOfClient, roleName, params)
+	return res0, call.end(err), err
+}
+
+// GetUsersByRoleName calls GoKeycloak.GetUsersByRoleName and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUsersByRoleName(ctx context.Context, token string, realm string, roleName string, params GetUsersByRoleParams, opts ...RequestOption) ([]*User, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUsersByRoleName", realm, opts)
+	_, res0, err := v.g.GetUsersByRoleName(ctx, token, realm, roleName, params)
+	return res0, call.end(err), err
+}
+
+// GetUsersManagementPermissions calls GoKeycloak.GetUsersManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GetUsersManagementPermissions(ctx context.Context, token string, realm string, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GetUsersManagementPermissions", realm, opts)
+	_, res0, err := v.g.GetUsersManagementPermissions(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// GrantUserPermission calls GoKeycloak.GrantUserPermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) GrantUserPermission(ctx context.Context, token string, realm string, permission PermissionGrantParams, opts ...RequestOption) (*PermissionGrantResponseRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "GrantUserPermission", realm, opts)
+	res0, err := v.g.GrantUserPermission(ctx, token, realm, permission)
+	return res0, call.end(err), err
+}
+
+// ImpersonateUser calls GoKeycloak.ImpersonateUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImpersonateUser(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) (*ImpersonationRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImpersonateUser", realm, opts)
+	_, res0, err := v.g.ImpersonateUser(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// ImpersonateUserWithTokenExchange calls GoKeycloak.ImpersonateUserWithTokenExchange and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImpersonateUserWithTokenExchange(ctx context.Context, realm string, options ImpersonationOptions, opts ...RequestOption) (*ImpersonationToken, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImpersonateUserWithTokenExchange", realm, opts)
+	_, res0, err := v.g.ImpersonateUserWithTokenExchange(ctx, realm, options)
+	return res0, call.end(err), err
+}
+
+// ImportIdentityProviderConfig calls GoKeycloak.ImportIdentityProviderConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImportIdentityProviderConfig(ctx context.Context, token string, realm string, fromURL string, providerID string, opts ...RequestOption) (map[string]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImportIdentityProviderConfig", realm, opts)
+	_, res0, err := v.g.ImportIdentityProviderConfig(ctx, token, realm, fromURL, providerID)
+	return res0, call.end(err), err
+}
+
+// ImportIdentityProviderConfigFromFile calls GoKeycloak.ImportIdentityProviderConfigFromFile and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImportIdentityProviderConfigFromFile(ctx context.Context, token string, realm string, providerID string, fileName string, fileBody io.Reader, opts ...RequestOption) (map[string]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImportIdentityProviderConfigFromFile", realm, opts)
+	_, res0, err := v.g.ImportIdentityProviderConfigFromFile(ctx, token, realm, providerID, fileName, fileBody)
+	return res0, call.end(err), err
+}
+
+// ImportRealmLocalizationTexts calls GoKeycloak.ImportRealmLocalizationTexts and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImportRealmLocalizationTexts(ctx context.Context, token string, realm string, locale string, texts map[string]string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImportRealmLocalizationTexts", realm, opts)
+	_, err := v.g.ImportRealmLocalizationTexts(ctx, token, realm, locale, texts)
+	return call.end(err), err
+}
+
+// ImportRealmMessages calls GoKeycloak.ImportRealmMessages and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ImportRealmMessages(ctx context.Context, token string, realm string, locale string, messages io.Reader, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ImportRealmMessages", realm, opts)
+	_, err := v.g.ImportRealmMessages(ctx, token, realm, locale, messages)
+	return call.end(err), err
+}
+
+// IntrospectToken calls GoKeycloak.IntrospectToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) IntrospectToken(ctx context.Context, accessToken string, clientID string, clientSecret string, realm string, opts ...RequestOption) (*IntroSpectTokenResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "IntrospectToken", realm, opts)
+	_, res0, err := v.g.IntrospectToken(ctx, accessToken, clientID, clientSecret, realm)
+	return res0, call.end(err), err
+}
+
+// LinkManagementPermissionPolicies calls GoKeycloak.LinkManagementPermissionPolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LinkManagementPermissionPolicies(ctx context.Context, token string, realm string, permissions ManagementPermissionReference, scope string, policyIDs []string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LinkManagementPermissionPolicies", realm, opts)
+	err := v.g.LinkManagementPermissionPolicies(ctx, token, realm, permissions, scope, policyIDs...)
+	return call.end(err), err
+}
+
+// Login calls GoKeycloak.Login and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) Login(ctx context.Context, clientID string, clientSecret string, realm string, username string, password string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "Login", realm, opts)
+	_, res0, err := v.g.Login(ctx, clientID, clientSecret, realm, username, password)
+	return res0, call.end(err), err
+}
+
+// LoginAdmin calls GoKeycloak.LoginAdmin and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LoginAdmin(ctx context.Context, username string, password string, realm string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LoginAdmin", realm, opts)
+	_, res0, err := v.g.LoginAdmin(ctx, username, password, realm)
+	return res0, call.end(err), err
+}
+
+// LoginClient calls GoKeycloak.LoginClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LoginClient(ctx context.Context, clientID string, clientSecret string, realm string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LoginClient", realm, opts)
+	_, res0, err := v.g.LoginClient(ctx, clientID, clientSecret, realm)
+	return res0, call.end(err), err
+}
+
+// LoginClientSignedJWT calls GoKeycloak.LoginClientSignedJWT and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LoginClientSignedJWT(ctx context.Context, clientID string, realm string, key interface{}, signedMethod jwt.SigningMethod, expiresAt *jwt.NumericDate, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LoginClientSignedJWT", realm, opts)
+	_, res0, err := v.g.LoginClientSignedJWT(ctx, clientID, realm, key, signedMethod, expiresAt)
+	return res0, call.end(err), err
+}
+
+// LoginClientTokenExchange calls GoKeycloak.LoginClientTokenExchange and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LoginClientTokenExchange(ctx context.Context, clientID string, token string, clientSecret string, realm string, targetClient string, userID string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LoginClientTokenExchange", realm, opts)
+	_, res0, err := v.g.LoginClientTokenExchange(ctx, clientID, token, clientSecret, realm, targetClient, userID)
+	return res0, call.end(err), err
+}
+
+// LoginOtp calls GoKeycloak.LoginOtp and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LoginOtp(ctx context.Context, clientID string, clientSecret string, realm string, username string, password string, totp string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LoginOtp", realm, opts)
+	_, res0, err := v.g.LoginOtp(ctx, clientID, clientSecret, realm, username, password, totp)
+	return res0, call.end(err), err
+}
+
+// Logout calls GoKeycloak.Logout and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) Logout(ctx context.Context, clientID string, clientSecret string, realm string, refreshToken string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "Logout", realm, opts)
+	_, err := v.g.Logout(ctx, clientID, clientSecret, realm, refreshToken)
+	return call.end(err), err
+}
+
+// LogoutAllSessions calls GoKeycloak.LogoutAllSessions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LogoutAllSessions(ctx context.Context, adminAccessToken string, realm string, userID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LogoutAllSessions", realm, opts)
+	_, err := v.g.LogoutAllSessions(ctx, adminAccessToken, realm, userID)
+	return call.end(err), err
+}
+
+// LogoutAllUsers calls GoKeycloak.LogoutAllUsers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LogoutAllUsers(ctx context.Context, token string, realm string, opts ...RequestOption) (*GlobalRequestResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LogoutAllUsers", realm, opts)
+	_, res0, err := v.g.LogoutAllUsers(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// LogoutPublicClient calls GoKeycloak.LogoutPublicClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LogoutPublicClient(ctx context.Context, clientID string, realm string, accessToken string, refreshToken string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LogoutPublicClient", realm, opts)
+	_, err := v.g.LogoutPublicClient(ctx, clientID, realm, accessToken, refreshToken)
+	return call.end(err), err
+}
+
+// LogoutSessions calls GoKeycloak.LogoutSessions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LogoutSessions(ctx context.Context, token string, realm string, filter SessionFilter, opts ...RequestOption) ([]*RealmSession, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LogoutSessions", realm, opts)
+	res0, err := v.g.LogoutSessions(ctx, token, realm, filter)
+	return res0, call.end(err), err
+}
+
+// LogoutUserSession calls GoKeycloak.LogoutUserSession and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LogoutUserSession(ctx context.Context, accessToken string, realm string, session string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LogoutUserSession", realm, opts)
+	_, err := v.g.LogoutUserSession(ctx, accessToken, realm, session)
+	return call.end(err), err
+}
+
+// LowerAuthenticationExecutionPriority calls GoKeycloak.LowerAuthenticationExecutionPriority and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) LowerAuthenticationExecutionPriority(ctx context.Context, token string, realm string, executionID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "LowerAuthenticationExecutionPriority", realm, opts)
+	_, err := v.g.LowerAuthenticationExecutionPriority(ctx, token, realm, executionID)
+	return call.end(err), err
+}
+
+// MoveCredentialBehind calls GoKeycloak.MoveCredentialBehind and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) MoveCredentialBehind(ctx context.Context, token string, realm string, userID string, credentialID string, newPreviousCredentialID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "MoveCredentialBehind", realm, opts)
+	err := v.g.MoveCredentialBehind(ctx, token, realm, userID, credentialID, newPreviousCredentialID)
+	return call.end(err), err
+}
+
+// MoveCredentialToFirst calls GoKeycloak.MoveCredentialToFirst and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) MoveCredentialToFirst(ctx context.Context, token string, realm string, userID string, credentialID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "MoveCredentialToFirst", realm, opts)
+	err := v.g.MoveCredentialToFirst(ctx, token, realm, userID, credentialID)
+	return call.end(err), err
+}
+
+// PartialExportRealm calls GoKeycloak.PartialExportRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) PartialExportRealm(ctx context.Context, token string, realm string, params PartialExportParams, opts ...RequestOption) (*RealmRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "PartialExportRealm", realm, opts)
+	_, res0, err := v.g.PartialExportRealm(ctx, token, realm, params)
+	return res0, call.end(err), err
+}
+
+// PartialImportRealm calls GoKeycloak.PartialImportRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) PartialImportRealm(ctx context.Context, token string, realm string, partialImport PartialImportRepresentation, opts ...RequestOption) (*PartialImportResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "PartialImportRealm", realm, opts)
+	_, res0, err := v.g.PartialImportRealm(ctx, token, realm, partialImport)
+	return res0, call.end(err), err
+}
+
+// PlanRealm calls GoKeycloak.PlanRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) PlanRealm(ctx context.Context, token string, desired RealmRepresentation, options ReconcileOptions, opts ...RequestOption) (*RealmPlan, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "PlanRealm", "", opts)
+	res0, err := v.g.PlanRealm(ctx, token, desired, options)
+	return res0, call.end(err), err
+}
+
+// PushRevocation calls GoKeycloak.PushRevocation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) PushRevocation(ctx context.Context, token string, realm string, opts ...RequestOption) (*GlobalRequestResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "PushRevocation", realm, opts)
+	_, res0, err := v.g.PushRevocation(ctx, token, realm)
+	return res0, call.end(err), err
+}
+
+// RaiseAuthenticationExecutionPriority calls GoKeycloak.RaiseAuthenticationExecutionPriority and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RaiseAuthenticationExecutionPriority(ctx context.Context, token string, realm string, executionID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RaiseAuthenticationExecutionPriority", realm, opts)
+	_, err := v.g.RaiseAuthenticationExecutionPriority(ctx, token, realm, executionID)
+	return call.end(err), err
+}
+
+// ReconcileRealm calls GoKeycloak.ReconcileRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ReconcileRealm(ctx context.Context, token string, desired RealmRepresentation, options ReconcileOptions, opts ...RequestOption) (*RealmPlan, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ReconcileRealm", "", opts)
+	res0, err := v.g.ReconcileRealm(ctx, token, desired, options)
+	return res0, call.end(err), err
+}
+
+// RefreshToken calls GoKeycloak.RefreshToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RefreshToken(ctx context.Context, refreshToken string, clientID string, clientSecret string, realm string, opts ...RequestOption) (*JWT, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RefreshToken", realm, opts)
+	_, res0, err := v.g.RefreshToken(ctx, refreshToken, clientID, clientSecret, realm)
+	return res0, call.end(err), err
+}
+
+// RegenerateClientSecret calls GoKeycloak.RegenerateClientSecret and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RegenerateClientSecret(ctx context.Context, token string, realm string, idOfClient string, opts ...RequestOption) (*CredentialRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RegenerateClientSecret", realm, opts)
+	_, res0, err := v.g.RegenerateClientSecret(ctx, token, realm, idOfClient)
+	return res0, call.end(err), err
+}
+
+// RegisterRequiredAction calls GoKeycloak.RegisterRequiredAction and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RegisterRequiredAction(ctx context.Context, token string, realm string, requiredAction RequiredActionProviderRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RegisterRequiredAction", realm, opts)
+	_, err := v.g.RegisterRequiredAction(ctx, token, realm, requiredAction)
+	return call.end(err), err
+}
+
+// RemoveClientPolicy calls GoKeycloak.RemoveClientPolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveClientPolicy(ctx context.Context, token string, realm string, name string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveClientPolicy", realm, opts)
+	_, err := v.g.RemoveClientPolicy(ctx, token, realm, name)
+	return call.end(err), err
+}
+
+// RemoveClientProfile calls GoKeycloak.RemoveClientProfile and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveClientProfile(ctx context.Context, token string, realm string, name string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveClientProfile", realm, opts)
+	_, err := v.g.RemoveClientProfile(ctx, token, realm, name)
+	return call.end(err), err
+}
+
+// RemoveDefaultGroup calls GoKeycloak.RemoveDefaultGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveDefaultGroup(ctx context.Context, token string, realm string, groupID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveDefaultGroup", realm, opts)
+	err := v.g.RemoveDefaultGroup(ctx, token, realm, groupID)
+	return call.end(err), err
+}
+
+// RemoveDefaultScopeFromClient calls GoKeycloak.RemoveDefaultScopeFromClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveDefaultScopeFromClient(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveDefaultScopeFromClient", realm, opts)
+	_, err := v.g.RemoveDefaultScopeFromClient(ctx, token, realm, idOfClient, scopeID)
+	return call.end(err), err
+}
+
+// RemoveImportedUsers calls GoKeycloak.RemoveImportedUsers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveImportedUsers(ctx context.Context, token string, realm string, storageID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveImportedUsers", realm, opts)
+	_, err := v.g.RemoveImportedUsers(ctx, token, realm, storageID)
+	return call.end(err), err
+}
+
+// RemoveOptionalScopeFromClient calls GoKeycloak.RemoveOptionalScopeFromClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RemoveOptionalScopeFromClient(ctx context.Context, token string, realm string, idOfClient string, scopeID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RemoveOptionalScopeFromClient", realm, opts)
+	_, err := v.g.RemoveOptionalScopeFromClient(ctx, token, realm, idOfClient, scopeID)
+	return call.end(err), err
+}
+
+// RevokeToken calls GoKeycloak.RevokeToken and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RevokeToken(ctx context.Context, realm string, clientID string, clientSecret string, refreshToken string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RevokeToken", realm, opts)
+	_, err := v.g.RevokeToken(ctx, realm, clientID, clientSecret, refreshToken)
+	return call.end(err), err
+}
+
+// RevokeUserConsents calls GoKeycloak.RevokeUserConsents and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RevokeUserConsents(ctx context.Context, accessToken string, realm string, userID string, clientID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RevokeUserConsents", realm, opts)
+	_, err := v.g.RevokeUserConsents(ctx, accessToken, realm, userID, clientID)
+	return call.end(err), err
+}
+
+// RevokeUserOfflineTokens calls GoKeycloak.RevokeUserOfflineTokens and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RevokeUserOfflineTokens(ctx context.Context, token string, realm string, userID string, opts ...RequestOption) ([]string, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RevokeUserOfflineTokens", realm, opts)
+	res0, err := v.g.RevokeUserOfflineTokens(ctx, token, realm, userID)
+	return res0, call.end(err), err
+}
+
+// RotateKeys calls GoKeycloak.RotateKeys and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) RotateKeys(ctx context.Context, token string, realm string, provider Component, gracePeriod time.Duration, opts ...RequestOption) (*KeyRotation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "RotateKeys", realm, opts)
+	res0, err := v.g.RotateKeys(ctx, token, realm, provider, gracePeriod)
+	return res0, call.end(err), err
+}
+
+// SendVerifyEmail calls GoKeycloak.SendVerifyEmail and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) SendVerifyEmail(ctx context.Context, token string, userID string, realm string, params []SendVerificationMailParams, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "SendVerifyEmail", realm, opts)
+	_, err := v.g.SendVerifyEmail(ctx, token, userID, realm, params...)
+	return call.end(err), err
+}
+
+// SetKeyProviderPriority calls GoKeycloak.SetKeyProviderPriority and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) SetKeyProviderPriority(ctx context.Context, token string, realm string, providerID string, priority int64, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "SetKeyProviderPriority", realm, opts)
+	_, err := v.g.SetKeyProviderPriority(ctx, token, realm, providerID, priority)
+	return call.end(err), err
+}
+
+// SetKeyProviderState calls GoKeycloak.SetKeyProviderState and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) SetKeyProviderState(ctx context.Context, token string, realm string, providerID string, enabled bool, active bool, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "SetKeyProviderState", realm, opts)
+	_, err := v.g.SetKeyProviderState(ctx, token, realm, providerID, enabled, active)
+	return call.end(err), err
+}
+
+// SetPassword calls GoKeycloak.SetPassword and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) SetPassword(ctx context.Context, token string, userID string, realm string, password string, temporary bool, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "SetPassword", realm, opts)
+	_, err := v.g.SetPassword(ctx, token, userID, realm, password, temporary)
+	return call.end(err), err
+}
+
+// SyncLDAPMapper calls GoKeycloak.SyncLDAPMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) SyncLDAPMapper(ctx context.Context, token string, realm string, ldapID string, mapperID string, direction LDAPSyncDirection, opts ...RequestOption) (*SynchronizationResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "SyncLDAPMapper", realm, opts)
+	_, res0, err := v.g.SyncLDAPMapper(ctx, token, realm, ldapID, mapperID, direction)
+	return res0, call.end(err), err
+}
+
+// TestLDAPConnection calls GoKeycloak.TestLDAPConnection and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) TestLDAPConnection(ctx context.Context, token string, realm string, test TestLDAPConnectionRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "TestLDAPConnection", realm, opts)
+	_, err := v.g.TestLDAPConnection(ctx, token, realm, test)
+	return call.end(err), err
+}
+
+// TriggerUserStorageChangedUsersSync calls GoKeycloak.TriggerUserStorageChangedUsersSync and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) TriggerUserStorageChangedUsersSync(ctx context.Context, token string, realm string, storageID string, opts ...RequestOption) (*SynchronizationResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "TriggerUserStorageChangedUsersSync", realm, opts)
+	_, res0, err := v.g.TriggerUserStorageChangedUsersSync(ctx, token, realm, storageID)
+	return res0, call.end(err), err
+}
+
+// TriggerUserStorageFullSync calls GoKeycloak.TriggerUserStorageFullSync and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) TriggerUserStorageFullSync(ctx context.Context, token string, realm string, storageID string, opts ...RequestOption) (*SynchronizationResult, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "TriggerUserStorageFullSync", realm, opts)
+	_, res0, err := v.g.TriggerUserStorageFullSync(ctx, token, realm, storageID)
+	return res0, call.end(err), err
+}
+
+// UnlinkUsers calls GoKeycloak.UnlinkUsers and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UnlinkUsers(ctx context.Context, token string, realm string, storageID string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UnlinkUsers", realm, opts)
+	_, err := v.g.UnlinkUsers(ctx, token, realm, storageID)
+	return call.end(err), err
+}
+
+// UpdateAuthenticationExecution calls GoKeycloak.UpdateAuthenticationExecution and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateAuthenticationExecution(ctx context.Context, token string, realm string, flow string, execution ModifyAuthenticationExecutionRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateAuthenticationExecution", realm, opts)
+	_, err := v.g.UpdateAuthenticationExecution(ctx, token, realm, flow, execution)
+	return call.end(err), err
+}
+
+// UpdateAuthenticationFlow calls GoKeycloak.UpdateAuthenticationFlow and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateAuthenticationFlow(ctx context.Context, token string, realm string, flow AuthenticationFlowRepresentation, authenticationFlowID string, opts ...RequestOption) (*AuthenticationFlowRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateAuthenticationFlow", realm, opts)
+	_, res0, err := v.g.UpdateAuthenticationFlow(ctx, token, realm, flow, authenticationFlowID)
+	return res0, call.end(err), err
+}
+
+// UpdateAuthenticatorConfig calls GoKeycloak.UpdateAuthenticatorConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateAuthenticatorConfig(ctx context.Context, token string, realm string, config AuthenticatorConfigRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateAuthenticatorConfig", realm, opts)
+	_, err := v.g.UpdateAuthenticatorConfig(ctx, token, realm, config)
+	return call.end(err), err
+}
+
+// UpdateClient calls GoKeycloak.UpdateClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClient(ctx context.Context, token string, realm string, updatedClient Client, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClient", realm, opts)
+	_, err := v.g.UpdateClient(ctx, token, realm, updatedClient)
+	return call.end(err), err
+}
+
+// UpdateClientManagementPermissions calls GoKeycloak.UpdateClientManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientManagementPermissions(ctx context.Context, token string, realm string, idOfClient string, permissions ManagementPermissionReference, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientManagementPermissions", realm, opts)
+	_, res0, err := v.g.UpdateClientManagementPermissions(ctx, token, realm, idOfClient, permissions)
+	return res0, call.end(err), err
+}
+
+// UpdateClientPolicies calls GoKeycloak.UpdateClientPolicies and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientPolicies(ctx context.Context, token string, realm string, policies ClientPoliciesRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientPolicies", realm, opts)
+	_, err := v.g.UpdateClientPolicies(ctx, token, realm, policies)
+	return call.end(err), err
+}
+
+// UpdateClientProfiles calls GoKeycloak.UpdateClientProfiles and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientProfiles(ctx context.Context, token string, realm string, profiles ClientProfilesRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientProfiles", realm, opts)
+	_, err := v.g.UpdateClientProfiles(ctx, token, realm, profiles)
+	return call.end(err), err
+}
+
+// UpdateClientProtocolMapper calls GoKeycloak.UpdateClientProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientProtocolMapper(ctx context.Context, token string, realm string, idOfClient string, mapperID string, mapper ProtocolMapperRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientProtocolMapper", realm, opts)
+	_, err := v.g.UpdateClientProtocolMapper(ctx, token, realm, idOfClient, mapperID, mapper)
+	return call.end(err), err
+}
+
+// UpdateClientRepresentation calls GoKeycloak.UpdateClientRepresentation and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientRepresentation(ctx context.Context, accessToken string, realm string, updatedClient Client, opts ...RequestOption) (*Client, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientRepresentation", realm, opts)
+	_, res0, err := v.g.UpdateClientRepresentation(ctx, accessToken, realm, updatedClient)
+	return res0, call.end(err), err
+}
+
+// UpdateClientScope calls GoKeycloak.UpdateClientScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientScope(ctx context.Context, token string, realm string, scope ClientScope, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientScope", realm, opts)
+	_, err := v.g.UpdateClientScope(ctx, token, realm, scope)
+	return call.end(err), err
+}
+
+// UpdateClientScopeProtocolMapper calls GoKeycloak.UpdateClientScopeProtocolMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateClientScopeProtocolMapper(ctx context.Context, token string, realm string, scopeID string, protocolMapper ProtocolMappers, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateClientScopeProtocolMapper", realm, opts)
+	_, err := v.g.UpdateClientScopeProtocolMapper(ctx, token, realm, scopeID, protocolMapper)
+	return call.end(err), err
+}
+
+// UpdateComponent calls GoKeycloak.UpdateComponent and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateComponent(ctx context.Context, token string, realm string, component Component, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateComponent", realm, opts)
+	err := v.g.UpdateComponent(ctx, token, realm, component)
+	return call.end(err), err
+}
+
+// UpdateCredentialUserLabel calls GoKeycloak.UpdateCredentialUserLabel and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateCredentialUserLabel(ctx context.Context, token string, realm string, userID string, credentialID string, userLabel string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateCredentialUserLabel", realm, opts)
+	err := v.g.UpdateCredentialUserLabel(ctx, token, realm, userID, credentialID, userLabel)
+	return call.end(err), err
+}
+
+// UpdateEventsConfig calls GoKeycloak.UpdateEventsConfig and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateEventsConfig(ctx context.Context, token string, realm string, config RealmEventsConfigRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateEventsConfig", realm, opts)
+	_, err := v.g.UpdateEventsConfig(ctx, token, realm, config)
+	return call.end(err), err
+}
+
+// UpdateGroup calls GoKeycloak.UpdateGroup and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateGroup(ctx context.Context, token string, realm string, updatedGroup Group, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateGroup", realm, opts)
+	_, err := v.g.UpdateGroup(ctx, token, realm, updatedGroup)
+	return call.end(err), err
+}
+
+// UpdateGroupManagementPermissions calls GoKeycloak.UpdateGroupManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateGroupManagementPermissions(ctx context.Context, token string, realm string, groupID string, permissions ManagementPermissionReference, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateGroupManagementPermissions", realm, opts)
+	_, res0, err := v.g.UpdateGroupManagementPermissions(ctx, token, realm, groupID, permissions)
+	return res0, call.end(err), err
+}
+
+// UpdateIdentityProvider calls GoKeycloak.UpdateIdentityProvider and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateIdentityProvider(ctx context.Context, token string, realm string, alias string, providerRep IdentityProviderRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateIdentityProvider", realm, opts)
+	_, err := v.g.UpdateIdentityProvider(ctx, token, realm, alias, providerRep)
+	return call.end(err), err
+}
+
+// UpdateIdentityProviderManagementPermissions calls GoKeycloak.UpdateIdentityProviderManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateIdentityProviderManagementPermissions(ctx context.Context, token string, realm string, alias string, permissions ManagementPermissionReference, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateIdentityProviderManagementPermissions", realm, opts)
+	_, res0, err := v.g.UpdateIdentityProviderManagementPermissions(ctx, token, realm, alias, permissions)
+	return res0, call.end(err), err
+}
+
+// UpdateIdentityProviderMapper calls GoKeycloak.UpdateIdentityProviderMapper and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateIdentityProviderMapper(ctx context.Context, token string, realm string, alias string, mapper IdentityProviderMapper, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateIdentityProviderMapper", realm, opts)
+	_, err := v.g.UpdateIdentityProviderMapper(ctx, token, realm, alias, mapper)
+	return call.end(err), err
+}
+
+// UpdatePermission calls GoKeycloak.UpdatePermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdatePermission(ctx context.Context, token string, realm string, idOfClient string, permission PermissionRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdatePermission", realm, opts)
+	err := v.g.UpdatePermission(ctx, token, realm, idOfClient, permission)
+	return call.end(err), err
+}
+
+// UpdatePolicy calls GoKeycloak.UpdatePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdatePolicy(ctx context.Context, token string, realm string, idOfClient string, policy PolicyRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdatePolicy", realm, opts)
+	err := v.g.UpdatePolicy(ctx, token, realm, idOfClient, policy)
+	return call.end(err), err
+}
+
+// UpdateRealm calls GoKeycloak.UpdateRealm and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRealm(ctx context.Context, token string, realm RealmRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRealm", PString(realm.Realm), opts)
+	_, err := v.g.UpdateRealm(ctx, token, realm)
+	return call.end(err), err
+}
+
+// UpdateRealmLocalizationText calls GoKeycloak.UpdateRealmLocalizationText and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRealmLocalizationText(ctx context.Context, token string, realm string, locale string, key string, text string, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRealmLocalizationText", realm, opts)
+	_, err := v.g.UpdateRealmLocalizationText(ctx, token, realm, locale, key, text)
+	return call.end(err), err
+}
+
+// UpdateRealmRole calls GoKeycloak.UpdateRealmRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRealmRole(ctx context.Context, token string, realm string, roleName string, role Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRealmRole", realm, opts)
+	_, err := v.g.UpdateRealmRole(ctx, token, realm, roleName, role)
+	return call.end(err), err
+}
+
+// UpdateRealmRoleByID calls GoKeycloak.UpdateRealmRoleByID and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRealmRoleByID(ctx context.Context, token string, realm string, roleID string, role Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRealmRoleByID", realm, opts)
+	_, err := v.g.UpdateRealmRoleByID(ctx, token, realm, roleID, role)
+	return call.end(err), err
+}
+
+// UpdateRequiredAction calls GoKeycloak.UpdateRequiredAction and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRequiredAction(ctx context.Context, token string, realm string, requiredAction RequiredActionProviderRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRequiredAction", realm, opts)
+	_, err := v.g.UpdateRequiredAction(ctx, token, realm, requiredAction)
+	return call.end(err), err
+}
+
+// UpdateResource calls GoKeycloak.UpdateResource and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateResource(ctx context.Context, token string, realm string, idOfClient string, resource ResourceRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateResource", realm, opts)
+	_, err := v.g.UpdateResource(ctx, token, realm, idOfClient, resource)
+	return call.end(err), err
+}
+
+// UpdateResourceClient calls GoKeycloak.UpdateResourceClient and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateResourceClient(ctx context.Context, token string, realm string, resource ResourceRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateResourceClient", realm, opts)
+	_, err := v.g.UpdateResourceClient(ctx, token, realm, resource)
+	return call.end(err), err
+}
+
+// UpdateResourcePolicy calls GoKeycloak.UpdateResourcePolicy and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateResourcePolicy(ctx context.Context, token string, realm string, permissionID string, policy ResourcePolicyRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateResourcePolicy", realm, opts)
+	err := v.g.UpdateResourcePolicy(ctx, token, realm, permissionID, policy)
+	return call.end(err), err
+}
+
+// UpdateRole calls GoKeycloak.UpdateRole and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRole(ctx context.Context, token string, realm string, idOfClient string, role Role, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRole", realm, opts)
+	_, err := v.g.UpdateRole(ctx, token, realm, idOfClient, role)
+	return call.end(err), err
+}
+
+// UpdateRoleManagementPermissions calls GoKeycloak.UpdateRoleManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateRoleManagementPermissions(ctx context.Context, token string, realm string, roleID string, permissions ManagementPermissionReference, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateRoleManagementPermissions", realm, opts)
+	_, res0, err := v.g.UpdateRoleManagementPermissions(ctx, token, realm, roleID, permissions)
+	return res0, call.end(err), err
+}
+
+// UpdateScope calls GoKeycloak.UpdateScope and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateScope(ctx context.Context, token string, realm string, idOfClient string, scope ScopeRepresentation, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateScope", realm, opts)
+	_, err := v.g.UpdateScope(ctx, token, realm, idOfClient, scope)
+	return call.end(err), err
+}
+
+// UpdateUser calls GoKeycloak.UpdateUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateUser(ctx context.Context, token string, realm string, user User, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateUser", realm, opts)
+	_, err := v.g.UpdateUser(ctx, token, realm, user)
+	return call.end(err), err
+}
+
+// UpdateUserPermission calls GoKeycloak.UpdateUserPermission and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateUserPermission(ctx context.Context, token string, realm string, permission PermissionGrantParams, opts ...RequestOption) (*PermissionGrantResponseRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateUserPermission", realm, opts)
+	res0, err := v.g.UpdateUserPermission(ctx, token, realm, permission)
+	return res0, call.end(err), err
+}
+
+// UpdateUserProfile calls GoKeycloak.UpdateUserProfile and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateUserProfile(ctx context.Context, token string, realm string, profile UserProfileConfig, opts ...RequestOption) (*UserProfileConfig, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateUserProfile", realm, opts)
+	_, res0, err := v.g.UpdateUserProfile(ctx, token, realm, profile)
+	return res0, call.end(err), err
+}
+
+// UpdateUsersManagementPermissions calls GoKeycloak.UpdateUsersManagementPermissions and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UpdateUsersManagementPermissions(ctx context.Context, token string, realm string, permissions ManagementPermissionReference, opts ...RequestOption) (*ManagementPermissionReference, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UpdateUsersManagementPermissions", realm, opts)
+	_, res0, err := v.g.UpdateUsersManagementPermissions(ctx, token, realm, permissions)
+	return res0, call.end(err), err
+}
+
+// UploadClientCertificate calls GoKeycloak.UploadClientCertificate and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UploadClientCertificate(ctx context.Context, token string, realm string, idOfClient string, attr string, params UploadCertificateParams, fileName string, file io.Reader, opts ...RequestOption) (*CertificateRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UploadClientCertificate", realm, opts)
+	_, res0, err := v.g.UploadClientCertificate(ctx, token, realm, idOfClient, attr, params, fileName, file)
+	return res0, call.end(err), err
+}
+
+// UploadClientKeyStore calls GoKeycloak.UploadClientKeyStore and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) UploadClientKeyStore(ctx context.Context, token string, realm string, idOfClient string, attr string, params UploadCertificateParams, fileName string, file io.Reader, opts ...RequestOption) (*CertificateRepresentation, *Response, error) {
+	ctx, call := v.g.beginCall(ctx, "UploadClientKeyStore", realm, opts)
+	_, res0, err := v.g.UploadClientKeyStore(ctx, token, realm, idOfClient, attr, params, fileName, file)
+	return res0, call.end(err), err
+}
+
+// ValidateUser calls GoKeycloak.ValidateUser and returns the HTTP response alongside the result
+func (v *GoKeycloakV2) ValidateUser(ctx context.Context, token string, realm string, user User, opts ...RequestOption) (*Response, error) {
+	ctx, call := v.g.beginCall(ctx, "ValidateUser", realm, opts)
+	err := v.g.ValidateUser(ctx, token, realm, user)
+	return call.end(err), err
+}
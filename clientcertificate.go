@@ -0,0 +1,185 @@
+package gokeycloak
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+)
+
+// ------------------------------
+// Client Certificates
+// ------------------------------
+
+// Attributes of the client certificates
+const (
+	// ClientCertificateJWT is the certificate verifying the jwts of clients authenticated by signed jwt
+	ClientCertificateJWT = "jwt.credential"
+	// ClientCertificateSAMLSigning is the certificate of SAML clients signing their documents
+	ClientCertificateSAMLSigning = "saml.signing"
+	// ClientCertificateSAMLEncryption is the certificate of SAML clients encrypting their documents
+	ClientCertificateSAMLEncryption = "saml.encryption"
+)
+
+// GetClientCertificate returns the certificate of a client, attr is e.g. ClientCertificateJWT
+func (g *GoKeycloak) GetClientCertificate(ctx context.Context, token, realm, idOfClient, attr string) (int, *CertificateRepresentation, error) {
+	const errMessage = "could not get client certificate"
+
+	var result CertificateRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "certificates", attr))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// GenerateClientCertificate generates a new key pair and certificate of a client.
+// The private key is only returned, Keycloak stores the certificate.
+func (g *GoKeycloak) GenerateClientCertificate(ctx context.Context, token, realm, idOfClient, attr string) (int, *CertificateRepresentation, error) {
+	const errMessage = "could not generate client certificate"
+
+	var result CertificateRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "certificates", attr, "generate"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// GenerateAndDownloadClientKeyStore generates a new key pair and certificate of a client
+// and returns them as keystore of the configured format, JKS or PKCS12
+func (g *GoKeycloak) GenerateAndDownloadClientKeyStore(ctx context.Context, token, realm, idOfClient, attr string, config ClientKeyStoreConfig) (int, []byte, error) {
+	const errMessage = "could not generate and download client keystore"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(config).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "certificates", attr, "generate-and-download"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), resp.Body(), nil
+}
+
+// DownloadClientKeyStore returns the certificate of a client as keystore of the configured format, JKS or PKCS12.
+// It holds the private key only if it was uploaded to Keycloak.
+func (g *GoKeycloak) DownloadClientKeyStore(ctx context.Context, token, realm, idOfClient, attr string, config ClientKeyStoreConfig) (int, []byte, error) {
+	const errMessage = "could not download client keystore"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(config).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "certificates", attr, "download"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), resp.Body(), nil
+}
+
+// UploadClientKeyStore uploads the certificate of a client with its private key, e.g. from a PKCS12 keystore
+func (g *GoKeycloak) UploadClientKeyStore(ctx context.Context, token, realm, idOfClient, attr string, params UploadCertificateParams, fileName string, file io.Reader) (int, *CertificateRepresentation, error) {
+	return g.uploadClientCertificate(ctx, token, realm, idOfClient, attr, "upload", params, fileName, file)
+}
+
+// UploadClientCertificate uploads the certificate of a client without its private key,
+// e.g. a PEM encoded certificate with KeyStoreFormatCertificatePEM
+func (g *GoKeycloak) UploadClientCertificate(ctx context.Context, token, realm, idOfClient, attr string, params UploadCertificateParams, fileName string, file io.Reader) (int, *CertificateRepresentation, error) {
+	return g.uploadClientCertificate(ctx, token, realm, idOfClient, attr, "upload-certificate", params, fileName, file)
+}
+
+func (g *GoKeycloak) uploadClientCertificate(ctx context.Context, token, realm, idOfClient, attr, action string, params UploadCertificateParams, fileName string, file io.Reader) (int, *CertificateRepresentation, error) {
+	const errMessage = "could not upload client certificate"
+
+	var result CertificateRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetFileReader("file", fileName, file).
+		SetFormData(params.FormData()).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "certificates", attr, action))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// ClientJWTSigner logs in a client with jwts signed by the private key of its certificate, see LoginClientSignedJWT
+type ClientJWTSigner struct {
+	g           *GoKeycloak
+	ClientID    string
+	Realm       string
+	Key         *rsa.PrivateKey
+	Certificate *x509.Certificate
+}
+
+// Login logs in the client with a jwt valid for expiresIn
+func (s *ClientJWTSigner) Login(ctx context.Context, expiresIn time.Duration) (int, *JWT, error) {
+	return s.g.LoginClientSignedJWT(ctx, s.ClientID, s.Realm, s.Key, jwt.SigningMethodRS256, jwt.NewNumericDate(time.Now().Add(expiresIn)))
+}
+
+// CreateClientJWTSigner generates a RSA key pair with a self-signed certificate valid for validFor and uploads
+// the certificate to the client, replacing its previous one. The private key never leaves the returned signer.
+// The client must authenticate by signed jwt, i.e. its clientAuthenticatorType is client-jwt.
+func (g *GoKeycloak) CreateClientJWTSigner(ctx context.Context, token, realm, idOfClient string, validFor time.Duration) (*ClientJWTSigner, error) {
+	const errMessage = "could not create client jwt signer"
+
+	_, client, err := g.GetClient(ctx, token, realm, idOfClient)
+	if err != nil {
+		return nil, err
+	}
+	clientID := PString(client.ClientID)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: clientID},
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+
+	certificatePEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	_, _, err = g.UploadClientCertificate(ctx, token, realm, idOfClient, ClientCertificateJWT,
+		UploadCertificateParams{KeyStoreFormat: KeyStoreFormatCertificatePEM}, clientID+".pem", bytes.NewReader(certificatePEM))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ClientJWTSigner{g: g, ClientID: clientID, Realm: realm, Key: key, Certificate: certificate}, nil
+}
@@ -0,0 +1,129 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+const certificateClientURL = "/admin/realms/test/clients/client-id"
+
+// fakeCertificates is a client with jwt.credential certificates in a fakeServer
+type fakeCertificates struct {
+	mu          sync.Mutex
+	format      string
+	filename    string
+	certificate *x509.Certificate
+}
+
+func (f *fakeCertificates) uploaded() (string, string, *x509.Certificate) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.format, f.filename, f.certificate
+}
+
+func (f *fakeCertificates) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.Method + " " + r.URL.Path {
+	case "GET " + certificateClientURL:
+		writeJSON(w, gokeycloak.Client{ID: gokeycloak.StringP("client-id"), ClientID: gokeycloak.StringP("signed-jwt")})
+	case "POST " + certificateClientURL + "/certificates/jwt.credential/upload-certificate":
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		block, _ := pem.Decode(data)
+		if block == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.format, f.filename, f.certificate = r.FormValue("keystoreFormat"), header.Filename, certificate
+		writeJSON(w, gokeycloak.CertificateRepresentation{Certificate: gokeycloak.StringP("uploaded")})
+	case "POST " + certificateClientURL + "/certificates/jwt.credential/download":
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write([]byte{0x30, 0x82})
+	case "POST /realms/test/protocol/openid-connect/token":
+		claims := jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(r.FormValue("client_assertion"), &claims, func(*jwt.Token) (interface{}, error) {
+			if f.certificate == nil {
+				return nil, io.EOF
+			}
+			return f.certificate.PublicKey, nil
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, gokeycloak.JWT{AccessToken: "token-of-" + claims.Subject})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_CreateClientJWTSigner(t *testing.T) {
+	t.Parallel()
+
+	certificates := &fakeCertificates{}
+	server := newFakeServer(t, certificates.ServeHTTP)
+	ctx := context.Background()
+
+	signer, err := server.client().CreateClientJWTSigner(ctx, "token", "test", "client-id", 24*time.Hour)
+	require.NoError(t, err)
+	format, filename, certificate := certificates.uploaded()
+	require.Equal(t, "Certificate PEM", format)
+	require.Equal(t, "signed-jwt.pem", filename)
+	require.Equal(t, "signed-jwt", signer.ClientID)
+	require.Equal(t, "signed-jwt", certificate.Subject.CommonName)
+	require.True(t, signer.Certificate.Equal(certificate))
+
+	_, token, err := signer.Login(ctx, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, "token-of-signed-jwt", token.AccessToken)
+	request := server.lastRequest(t, http.MethodPost, "/realms/test/protocol/openid-connect/token")
+	require.Contains(t, string(request.Body), "client_assertion_type=urn%3Aietf%3Aparams%3Aoauth%3Aclient-assertion-type%3Ajwt-bearer")
+}
+
+func Test_DownloadClientKeyStore(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, (&fakeCertificates{}).ServeHTTP)
+
+	_, keystore, err := server.client().DownloadClientKeyStore(context.Background(), "token", "test", "client-id", gokeycloak.ClientCertificateJWT, gokeycloak.ClientKeyStoreConfig{
+		Format:        gokeycloak.KeyStoreFormatPKCS12,
+		KeyAlias:      gokeycloak.StringP("signed-jwt"),
+		StorePassword: gokeycloak.StringP("secret"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x30, 0x82}, keystore)
+	request := server.lastRequest(t, http.MethodPost, certificateClientURL+"/certificates/jwt.credential/download")
+	require.JSONEq(t, `{"format": "PKCS12", "keyAlias": "signed-jwt", "storePassword": "secret"}`, string(request.Body))
+}
+
+func Test_GetClientCertificate_Missing(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, (&fakeCertificates{}).ServeHTTP)
+
+	_, _, err := server.client().GetClientCertificate(context.Background(), "token", "test", "missing", gokeycloak.ClientCertificateJWT)
+	require.Error(t, err)
+}
@@ -0,0 +1,177 @@
+package gokeycloak
+
+import (
+	"context"
+	"strconv"
+)
+
+// ------------------------------
+// Client Policies and Profiles
+// ------------------------------
+
+// GetClientPolicies returns the client policies of the realm, the global policies only if includeGlobal is set
+func (g *GoKeycloak) GetClientPolicies(ctx context.Context, token, realm string, includeGlobal bool) (int, *ClientPoliciesRepresentation, error) {
+	const errMessage = "could not get client policies"
+
+	var result ClientPoliciesRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("include-global-policies", strconv.FormatBool(includeGlobal)).
+		Get(g.getAdminRealmURL(realm, "client-policies", "policies"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// UpdateClientPolicies replaces the client policies of the realm. Global policies can not be changed and are not sent.
+func (g *GoKeycloak) UpdateClientPolicies(ctx context.Context, token, realm string, policies ClientPoliciesRepresentation) (int, error) {
+	const errMessage = "could not update client policies"
+
+	policies.GlobalPolicies = nil
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(policies).
+		Put(g.getAdminRealmURL(realm, "client-policies", "policies"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetClientProfiles returns the client profiles of the realm, the global profiles only if includeGlobal is set
+func (g *GoKeycloak) GetClientProfiles(ctx context.Context, token, realm string, includeGlobal bool) (int, *ClientProfilesRepresentation, error) {
+	const errMessage = "could not get client profiles"
+
+	var result ClientProfilesRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("include-global-profiles", strconv.FormatBool(includeGlobal)).
+		Get(g.getAdminRealmURL(realm, "client-policies", "profiles"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// UpdateClientProfiles replaces the client profiles of the realm. Global profiles can not be changed and are not sent.
+func (g *GoKeycloak) UpdateClientProfiles(ctx context.Context, token, realm string, profiles ClientProfilesRepresentation) (int, error) {
+	const errMessage = "could not update client profiles"
+
+	profiles.GlobalProfiles = nil
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(profiles).
+		Put(g.getAdminRealmURL(realm, "client-policies", "profiles"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// AddClientPolicy adds the policy to the client policies of the realm or replaces the policy of the same name.
+// The other policies are kept.
+// Keycloak only replaces all policies at once, so a concurrent change of another policy between reading and writing
+// the policies is lost. Serialize the changes of the client policies of a realm.
+func (g *GoKeycloak) AddClientPolicy(ctx context.Context, token, realm string, policy ClientPolicyDefinition) (int, error) {
+	status, policies, err := g.GetClientPolicies(ctx, token, realm, false)
+	if err != nil {
+		return status, err
+	}
+
+	result := []ClientPolicyDefinition{}
+	replaced := false
+	if policies.Policies != nil {
+		for _, p := range *policies.Policies {
+			if PString(p.Name) == PString(policy.Name) {
+				p, replaced = policy, true
+			}
+			result = append(result, p)
+		}
+	}
+	if !replaced {
+		result = append(result, policy)
+	}
+	policies.Policies = &result
+
+	return g.UpdateClientPolicies(ctx, token, realm, *policies)
+}
+
+// RemoveClientPolicy removes the policy with the given name from the client policies of the realm.
+// The other policies are kept, nothing is changed if there is no such policy.
+// Like AddClientPolicy, it loses concurrent changes of the client policies.
+func (g *GoKeycloak) RemoveClientPolicy(ctx context.Context, token, realm, name string) (int, error) {
+	status, policies, err := g.GetClientPolicies(ctx, token, realm, false)
+	if err != nil {
+		return status, err
+	}
+	if policies.Policies == nil {
+		return status, nil
+	}
+
+	result := []ClientPolicyDefinition{}
+	for _, p := range *policies.Policies {
+		if PString(p.Name) != name {
+			result = append(result, p)
+		}
+	}
+	if len(result) == len(*policies.Policies) {
+		return status, nil
+	}
+	policies.Policies = &result
+
+	return g.UpdateClientPolicies(ctx, token, realm, *policies)
+}
+
+// AddClientProfile adds the profile to the client profiles of the realm or replaces the profile of the same name.
+// The other profiles are kept.
+// Keycloak only replaces all profiles at once, so a concurrent change of another profile between reading and writing
+// the profiles is lost. Serialize the changes of the client profiles of a realm.
+func (g *GoKeycloak) AddClientProfile(ctx context.Context, token, realm string, profile ClientProfileRepresentation) (int, error) {
+	status, profiles, err := g.GetClientProfiles(ctx, token, realm, false)
+	if err != nil {
+		return status, err
+	}
+
+	result := []ClientProfileRepresentation{}
+	replaced := false
+	if profiles.Profiles != nil {
+		for _, p := range *profiles.Profiles {
+			if PString(p.Name) == PString(profile.Name) {
+				p, replaced = profile, true
+			}
+			result = append(result, p)
+		}
+	}
+	if !replaced {
+		result = append(result, profile)
+	}
+	profiles.Profiles = &result
+
+	return g.UpdateClientProfiles(ctx, token, realm, *profiles)
+}
+
+// RemoveClientProfile removes the profile with the given name from the client profiles of the realm.
+// The other profiles are kept, nothing is changed if there is no such profile.
+// Keycloak rejects the removal of a profile still used by a policy.
+// Like AddClientProfile, it loses concurrent changes of the client profiles.
+func (g *GoKeycloak) RemoveClientProfile(ctx context.Context, token, realm, name string) (int, error) {
+	status, profiles, err := g.GetClientProfiles(ctx, token, realm, false)
+	if err != nil {
+		return status, err
+	}
+	if profiles.Profiles == nil {
+		return status, nil
+	}
+
+	result := []ClientProfileRepresentation{}
+	for _, p := range *profiles.Profiles {
+		if PString(p.Name) != name {
+			result = append(result, p)
+		}
+	}
+	if len(result) == len(*profiles.Profiles) {
+		return status, nil
+	}
+	profiles.Profiles = &result
+
+	return g.UpdateClientProfiles(ctx, token, realm, *profiles)
+}
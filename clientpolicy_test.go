@@ -0,0 +1,140 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeClientPolicies holds the client policies and profiles of realm test
+type fakeClientPolicies struct {
+	mu     sync.Mutex
+	stored map[string]string
+}
+
+func newFakeClientPolicies(t *testing.T) (*fakeServer, *fakeClientPolicies) {
+	t.Helper()
+
+	policies := &fakeClientPolicies{stored: map[string]string{
+		"policies": `{"policies": [{"name": "pkce", "enabled": true, "conditions": [{"condition": "client-access-type", "configuration": {"type": ["public"]}}], "profiles": ["pkce-profile"]}]}`,
+		"profiles": `{"profiles": [{"name": "pkce-profile", "executors": [{"executor": "pkce-enforcer", "configuration": {"auto-configure": "true"}}]}]}`,
+	}}
+	return newFakeServer(t, policies.ServeHTTP), policies
+}
+
+// get returns the stored policies or profiles
+func (f *fakeClientPolicies) get(kind string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.stored[kind]
+}
+
+func (f *fakeClientPolicies) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	global := map[string]string{
+		"policies": `"globalPolicies": [{"name": "global-policy"}]`,
+		"profiles": `"globalProfiles": [{"name": "fapi-1-baseline"}]`,
+	}
+	kind := strings.TrimPrefix(r.URL.Path, "/admin/realms/test/client-policies/")
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		response := f.stored[kind]
+		if r.URL.Query().Get("include-global-"+kind) == "true" {
+			response = strings.TrimSuffix(response, "}") + ", " + global[kind] + "}"
+		}
+		_, _ = w.Write([]byte(response))
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.stored[kind] = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func Test_ClientPolicies(t *testing.T) {
+	t.Parallel()
+
+	server, stored := newFakeClientPolicies(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, policies, err := client.GetClientPolicies(ctx, "token", "test", true)
+	require.NoError(t, err)
+	require.Len(t, *policies.Policies, 1)
+	require.Len(t, *policies.GlobalPolicies, 1)
+	condition := (*(*policies.Policies)[0].Conditions)[0]
+	require.Equal(t, []interface{}{"public"}, (*condition.Configuration)["type"])
+
+	_, err = client.UpdateClientPolicies(ctx, "token", "test", *policies)
+	require.NoError(t, err)
+	require.NotContains(t, stored.get("policies"), "globalPolicies", "global policies are read only")
+
+	_, profiles, err := client.GetClientProfiles(ctx, "token", "test", true)
+	require.NoError(t, err)
+	require.Equal(t, "fapi-1-baseline", *(*profiles.GlobalProfiles)[0].Name)
+	require.Equal(t, "pkce-enforcer", *(*(*profiles.Profiles)[0].Executors)[0].Executor)
+}
+
+func Test_AddAndRemoveClientPolicies(t *testing.T) {
+	t.Parallel()
+
+	server, stored := newFakeClientPolicies(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, err := client.AddClientProfile(ctx, "token", "test", gokeycloak.ClientProfileRepresentation{
+		Name: gokeycloak.StringP("secure-auth"),
+		Executors: &[]gokeycloak.ClientPolicyExecutorRepresentation{{
+			Executor:      gokeycloak.StringP("secure-client-authenticator"),
+			Configuration: &map[string]interface{}{"allowed-client-authenticators": []string{"client-jwt"}},
+		}},
+	})
+	require.NoError(t, err)
+	status, err := client.AddClientPolicy(ctx, "token", "test", gokeycloak.ClientPolicyDefinition{
+		Name:       gokeycloak.StringP("confidential"),
+		Enabled:    gokeycloak.BoolP(true),
+		Conditions: &[]gokeycloak.ClientPolicyConditionRepresentation{{Condition: gokeycloak.StringP("client-access-type"), Configuration: &map[string]interface{}{"type": []string{"confidential"}}}},
+		Profiles:   &[]string{"secure-auth"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+	_, err = client.AddClientPolicy(ctx, "token", "test", gokeycloak.ClientPolicyDefinition{
+		Name:     gokeycloak.StringP("pkce"),
+		Enabled:  gokeycloak.BoolP(false),
+		Profiles: &[]string{"pkce-profile"},
+	})
+	require.NoError(t, err)
+
+	_, policies, err := client.GetClientPolicies(ctx, "token", "test", false)
+	require.NoError(t, err)
+	require.Len(t, *policies.Policies, 2)
+	require.False(t, *(*policies.Policies)[0].Enabled, "a policy of the same name is replaced in place")
+	require.Equal(t, "confidential", *(*policies.Policies)[1].Name)
+
+	_, err = client.RemoveClientPolicy(ctx, "token", "test", "pkce")
+	require.NoError(t, err)
+	status, err = client.RemoveClientPolicy(ctx, "token", "test", "missing")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status, "nothing is written")
+	_, err = client.RemoveClientProfile(ctx, "token", "test", "pkce-profile")
+	require.NoError(t, err)
+
+	var storedPolicies, storedProfiles map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(stored.get("policies")), &storedPolicies))
+	require.NoError(t, json.Unmarshal([]byte(stored.get("profiles")), &storedProfiles))
+	require.Len(t, storedPolicies["policies"], 1)
+	require.Equal(t, "confidential", storedPolicies["policies"][0]["name"])
+	require.Len(t, storedProfiles["profiles"], 1)
+	require.Equal(t, "secure-auth", storedProfiles["profiles"][0]["name"])
+}
@@ -0,0 +1,58 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fakeConsents(t *testing.T) *fakeServer {
+	t.Helper()
+
+	return newFakeServer(t, jsonResponses(map[string]string{
+		"GET /admin/realms/test/users/u1/consents": `[
+			{"clientId": "portal", "grantedClientScopes": ["profile", "email"], "createdDate": 1700000000000, "lastUpdatedDate": 1700000100000},
+			{"clientId": "mobile", "grantedClientScopes": ["offline_access"], "createdDate": 1700000000000,
+			 "additionalGrants": [{"client": "mobile", "key": "Offline Token"}]}
+		]`,
+		"GET /admin/realms/test/clients?clientId=mobile":       `[{"id": "c2", "clientId": "mobile"}]`,
+		"GET /admin/realms/test/users/u1/offline-sessions/c2":  `[{"id": "s1", "userId": "u1", "clients": {"c2": "mobile"}}]`,
+		"DELETE /admin/realms/test/users/u1/consents/mobile":   "",
+		"DELETE /admin/realms/test/sessions/s1?isOffline=true": "",
+	}))
+}
+
+func Test_GetUserConsents(t *testing.T) {
+	t.Parallel()
+
+	_, consents, err := fakeConsents(t).client().GetUserConsents(context.Background(), "token", "test", "u1")
+	require.NoError(t, err)
+	require.Len(t, consents, 2)
+	require.Equal(t, []string{"profile", "email"}, *consents[0].GrantedClientScopes)
+	require.Equal(t, int64(1700000100000), *consents[0].LastUpdatedDate)
+	require.False(t, consents[0].HasOfflineToken())
+	require.True(t, consents[1].HasOfflineToken())
+}
+
+func Test_RevokeUserOfflineTokens(t *testing.T) {
+	t.Parallel()
+
+	server := fakeConsents(t)
+
+	revoked, err := server.client().RevokeUserOfflineTokens(context.Background(), "token", "test", "u1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"mobile"}, revoked)
+
+	var deleted []string
+	for _, request := range server.recorded() {
+		if request.Method == http.MethodDelete {
+			deleted = append(deleted, request.URI)
+		}
+	}
+	require.Equal(t, []string{
+		"/admin/realms/test/users/u1/consents/mobile",
+		"/admin/realms/test/sessions/s1?isOffline=true",
+	}, deleted)
+}
@@ -0,0 +1,326 @@
+package gokeycloak
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DifferenceKind is the kind of a difference between two realms
+type DifferenceKind string
+
+// DifferenceKind values
+const (
+	// DifferenceAdded is a value which only exists in the right realm
+	DifferenceAdded DifferenceKind = "added"
+	// DifferenceRemoved is a value which only exists in the left realm
+	DifferenceRemoved DifferenceKind = "removed"
+	// DifferenceChanged is a value which differs between the realms
+	DifferenceChanged DifferenceKind = "changed"
+)
+
+// RealmDifference is a difference between two realms at a single path
+type RealmDifference struct {
+	// Path is the slash separated path of the value. Lists of objects with a natural key
+	// (clientId, alias or name) are keyed by it, e.g. clients/my-app/redirectUris.
+	// A slash in a key is written as ~1 and a tilde as ~0, like in JSON pointers.
+	Path  string         `json:"path"`
+	Kind  DifferenceKind `json:"kind"`
+	Left  interface{}    `json:"left,omitempty"`
+	Right interface{}    `json:"right,omitempty"`
+}
+
+// RealmDiff is the result of DiffRealms
+type RealmDiff struct {
+	Left        string            `json:"left"`
+	Right       string            `json:"right"`
+	Differences []RealmDifference `json:"differences"`
+}
+
+// DiffOptions are the options of DiffRealms
+type DiffOptions struct {
+	// LeftName and RightName label the realms in the report, the realm names by default
+	LeftName  string
+	RightName string
+	// IgnorePaths are paths or path.Match patterns whose values and children are not compared, e.g. "clients/*/attributes"
+	IgnorePaths []string
+	// CompareSecrets compares secrets, which are ignored by default as Keycloak returns them masked
+	CompareSecrets bool
+}
+
+// diffNoise are fields generated by the server, they are never compared
+var diffNoise = []string{"id", "containerId", "internalId", "flowId", "keycloakVersion"}
+
+// diffIdentityKeys are the fields used to key lists of objects, in order of preference
+var diffIdentityKeys = []string{"clientId", "alias", "name"}
+
+// secretFields are compared only if DiffOptions.CompareSecrets is set, as are all fields containing "secret"
+var secretFields = []string{"bindCredential", "password", "privateKey"}
+
+// Empty returns true if the realms do not differ
+func (d *RealmDiff) Empty() bool {
+	return len(d.Differences) == 0
+}
+
+// String returns a unified diff style report of the differences
+func (d *RealmDiff) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n+++ %s\n", d.Left, d.Right)
+	for _, difference := range d.Differences {
+		fmt.Fprintf(&b, "@@ %s @@\n", difference.Path)
+		if difference.Kind != DifferenceAdded {
+			writeReportValue(&b, "-", difference.Left)
+		}
+		if difference.Kind != DifferenceRemoved {
+			writeReportValue(&b, "+", difference.Right)
+		}
+	}
+
+	return b.String()
+}
+
+func writeReportValue(b *strings.Builder, prefix string, value interface{}) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		data = []byte(fmt.Sprint(value))
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fmt.Fprintf(b, "%s%s\n", prefix, line)
+	}
+}
+
+// ReadRealmFile reads a realm representation from a JSON file, e.g. a realm export
+func ReadRealmFile(name string) (*RealmRepresentation, error) {
+	const errMessage = "could not read realm file"
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	realm, err := ParseRealm(data)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+
+	return realm, nil
+}
+
+// ParseRealm decodes a realm representation in JSON, e.g. a realm export.
+// Unlike json.Unmarshal, the clients are decoded with the camel case names of the admin API,
+// which Client does not use as it is the representation of the client registration API.
+func ParseRealm(data []byte) (*RealmRepresentation, error) {
+	var realm RealmRepresentation
+	if err := json.Unmarshal(data, &realm); err != nil {
+		return nil, err
+	}
+	var clients struct {
+		Clients *[]adminClient `json:"clients"`
+	}
+	if err := json.Unmarshal(data, &clients); err != nil {
+		return nil, err
+	}
+	if clients.Clients != nil {
+		result := adminClients(*clients.Clients)
+		realm.Clients = &result
+	}
+
+	return &realm, nil
+}
+
+// DiffRealms compares two realms, e.g. realms read by GetFullRealm from two servers or a realm and a realm export.
+// Server generated ids, the order of lists and, unless requested, secrets are normalized before comparing.
+// Sections only one realm contains are reported as a whole, use IgnorePaths to skip them.
+func DiffRealms(left, right RealmRepresentation, options DiffOptions) (*RealmDiff, error) {
+	diff := &RealmDiff{Left: options.LeftName, Right: options.RightName, Differences: []RealmDifference{}}
+	if diff.Left == "" {
+		diff.Left = PString(left.Realm)
+	}
+	if diff.Right == "" {
+		diff.Right = PString(right.Realm)
+	}
+
+	l, err := normalizeRealm(left, options)
+	if err != nil {
+		return nil, err
+	}
+	r, err := normalizeRealm(right, options)
+	if err != nil {
+		return nil, err
+	}
+	diff.Differences = diffValues("", l, r, options.IgnorePaths, diff.Differences)
+
+	return diff, nil
+}
+
+// normalizeRealm returns the realm as a JSON value with lists of objects keyed by their natural key
+func normalizeRealm(realm RealmRepresentation, options DiffOptions) (interface{}, error) {
+	const errMessage = "could not normalize realm"
+
+	// clients are encoded with the names of the client registration API, use the admin API names instead
+	var clients []interface{}
+	if realm.Clients != nil {
+		for _, client := range *realm.Clients {
+			representation, err := client.adminRepresentation()
+			if err != nil {
+				return nil, errors.Wrap(err, errMessage)
+			}
+			clients = append(clients, representation)
+		}
+		realm.Clients = nil
+	}
+
+	value, ok := toJSONValue(realm).(map[string]interface{})
+	if !ok {
+		return nil, errors.New(errMessage)
+	}
+	if clients != nil {
+		value["clients"] = toJSONValue(clients)
+	}
+
+	return normalizeValue(value, options.CompareSecrets), nil
+}
+
+func normalizeValue(value interface{}, compareSecrets bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, field := range v {
+			if containsString(diffNoise, key) {
+				continue
+			}
+			if !compareSecrets && isSecretField(key) {
+				field = secretMask
+			}
+			// empty lists and objects are omitted like missing ones
+			if field = normalizeValue(field, compareSecrets); !isEmptyJSON(field) {
+				result[key] = field
+			}
+		}
+		return result
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			items = append(items, normalizeValue(item, compareSecrets))
+		}
+		if keyed, ok := keyByIdentity(items); ok {
+			return keyed
+		}
+		sort.SliceStable(items, func(i, j int) bool { return canonicalJSON(items[i]) < canonicalJSON(items[j]) })
+		return items
+	default:
+		return v
+	}
+}
+
+func isEmptyJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return value == nil
+}
+
+func isSecretField(key string) bool {
+	return strings.Contains(strings.ToLower(key), "secret") || containsString(secretFields, key)
+}
+
+// keyByIdentity turns a list of objects into an object keyed by the first identity field all items have unique values for
+func keyByIdentity(items []interface{}) (map[string]interface{}, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+	for _, key := range diffIdentityKeys {
+		keyed := make(map[string]interface{}, len(items))
+		for _, item := range items {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, false
+			}
+			identity, ok := object[key].(string)
+			if !ok || keyed[identity] != nil {
+				break
+			}
+			keyed[identity] = object
+		}
+		if len(keyed) == len(items) {
+			return keyed, true
+		}
+	}
+	return nil, false
+}
+
+func canonicalJSON(value interface{}) string {
+	data, _ := json.Marshal(value)
+	return string(data)
+}
+
+// diffValues appends the differences between left and right below path to differences
+func diffValues(basePath string, left, right interface{}, ignore []string, differences []RealmDifference) []RealmDifference {
+	if ignoredPath(basePath, ignore) {
+		return differences
+	}
+
+	l, leftObject := left.(map[string]interface{})
+	r, rightObject := right.(map[string]interface{})
+	if leftObject && rightObject {
+		keys := make([]string, 0, len(l)+len(r))
+		for key := range l {
+			keys = append(keys, key)
+		}
+		for key := range r {
+			if _, ok := l[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			differences = diffValues(joinDiffPath(basePath, key), l[key], r[key], ignore, differences)
+		}
+		return differences
+	}
+
+	switch {
+	case reflect.DeepEqual(left, right):
+		return differences
+	case left == nil:
+		return append(differences, RealmDifference{Path: basePath, Kind: DifferenceAdded, Right: right})
+	case right == nil:
+		return append(differences, RealmDifference{Path: basePath, Kind: DifferenceRemoved, Left: left})
+	default:
+		return append(differences, RealmDifference{Path: basePath, Kind: DifferenceChanged, Left: left, Right: right})
+	}
+}
+
+func joinDiffPath(basePath, key string) string {
+	key = strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+	if basePath == "" {
+		return key
+	}
+	return basePath + "/" + key
+}
+
+// ignoredPath reports whether p or one of its parents matches an ignored path or pattern
+func ignoredPath(p string, ignore []string) bool {
+	if p == "" {
+		return false
+	}
+	for _, pattern := range ignore {
+		for parent := p; ; parent = parent[:strings.LastIndex(parent, "/")] {
+			if matched, _ := path.Match(pattern, parent); matched || parent == pattern {
+				return true
+			}
+			if !strings.Contains(parent, "/") {
+				break
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package gokeycloak_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_DiffRealms(t *testing.T) {
+	t.Parallel()
+
+	staging := `{
+		"id": "1", "realm": "test", "displayName": "Test", "keycloakVersion": "24.0.0",
+		"clients": [
+			{"id": "a1", "clientId": "app", "secret": "s1", "redirectUris": ["https://a", "https://b"],
+			 "protocolMappers": [{"id": "m1", "name": "email", "protocolMapper": "oidc-usermodel-property-mapper"}]},
+			{"id": "a2", "clientId": "old", "attributes": {"pkce.code.challenge.method": "S256"}}
+		],
+		"roles": {"realm": [{"id": "r1", "name": "viewer", "containerId": "1"}]},
+		"groups": []
+	}`
+	production := `{
+		"id": "2", "realm": "test", "displayName": "Production", "keycloakVersion": "25.0.0",
+		"clients": [
+			{"id": "b3", "clientId": "new"},
+			{"id": "b1", "clientId": "app", "secret": "**********", "redirectUris": ["https://b", "https://a"],
+			 "protocolMappers": [{"id": "m2", "name": "email", "protocolMapper": "oidc-usermodel-attribute-mapper"}]}
+		],
+		"roles": {"realm": [{"id": "r2", "name": "viewer", "containerId": "2"}]}
+	}`
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "staging.json"), []byte(staging), 0o600))
+	left, err := gokeycloak.ReadRealmFile(filepath.Join(dir, "staging.json"))
+	require.NoError(t, err)
+	right, err := gokeycloak.ParseRealm([]byte(production))
+	require.NoError(t, err)
+
+	diff, err := gokeycloak.DiffRealms(*left, *right, gokeycloak.DiffOptions{LeftName: "staging", RightName: "production"})
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.RealmDifference{
+		{Path: "clients/app/protocolMappers/email/protocolMapper", Kind: gokeycloak.DifferenceChanged,
+			Left: "oidc-usermodel-property-mapper", Right: "oidc-usermodel-attribute-mapper"},
+		{Path: "clients/new", Kind: gokeycloak.DifferenceAdded, Right: map[string]interface{}{"clientId": "new"}},
+		{Path: "clients/old", Kind: gokeycloak.DifferenceRemoved, Left: map[string]interface{}{
+			"clientId": "old", "attributes": map[string]interface{}{"pkce.code.challenge.method": "S256"},
+		}},
+		{Path: "displayName", Kind: gokeycloak.DifferenceChanged, Left: "Test", Right: "Production"},
+	}, diff.Differences)
+	require.Equal(t, `--- staging
++++ production
+@@ clients/app/protocolMappers/email/protocolMapper @@
+-"oidc-usermodel-property-mapper"
++"oidc-usermodel-attribute-mapper"
+@@ clients/new @@
++{
++  "clientId": "new"
++}
+@@ clients/old @@
+-{
+-  "attributes": {
+-    "pkce.code.challenge.method": "S256"
+-  },
+-  "clientId": "old"
+-}
+@@ displayName @@
+-"Test"
++"Production"
+`, diff.String())
+
+	diff, err = gokeycloak.DiffRealms(*left, *right, gokeycloak.DiffOptions{
+		IgnorePaths:    []string{"displayName", "clients/*/protocolMappers", "clients/new", "clients/old"},
+		CompareSecrets: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.RealmDifference{
+		{Path: "clients/app/secret", Kind: gokeycloak.DifferenceChanged, Left: "s1", Right: "**********"},
+	}, diff.Differences)
+}
@@ -0,0 +1,228 @@
+package gokeycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// ------------------------------
+// Client Scope Evaluation
+// ------------------------------
+
+// ExampleToken holds the claims of a token generated by the client scope evaluation.
+// Claims holds all claims, the common ones are parsed into the other fields.
+type ExampleToken struct {
+	Subject           string
+	Issuer            string
+	Audience          []string
+	Scope             string
+	PreferredUsername string
+	Email             string
+	Name              string
+	RealmRoles        []string
+	ClientRoles       map[string][]string
+	Claims            jwt.MapClaims
+}
+
+// UnmarshalJSON parses the claims of the token
+func (t *ExampleToken) UnmarshalJSON(data []byte) error {
+	var claims struct {
+		Subject           string      `json:"sub"`
+		Issuer            string      `json:"iss"`
+		Audience          interface{} `json:"aud"`
+		Scope             string      `json:"scope"`
+		PreferredUsername string      `json:"preferred_username"`
+		Email             string      `json:"email"`
+		Name              string      `json:"name"`
+		RealmAccess       struct {
+			Roles []string `json:"roles"`
+		} `json:"realm_access"`
+		ResourceAccess map[string]struct {
+			Roles []string `json:"roles"`
+		} `json:"resource_access"`
+	}
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return err
+	}
+	*t = ExampleToken{
+		Subject:           claims.Subject,
+		Issuer:            claims.Issuer,
+		Scope:             claims.Scope,
+		PreferredUsername: claims.PreferredUsername,
+		Email:             claims.Email,
+		Name:              claims.Name,
+		RealmRoles:        claims.RealmAccess.Roles,
+		ClientRoles:       map[string][]string{},
+	}
+	switch aud := claims.Audience.(type) {
+	case string:
+		t.Audience = []string{aud}
+	case []interface{}:
+		for _, a := range aud {
+			t.Audience = append(t.Audience, fmt.Sprint(a))
+		}
+	}
+	for client, access := range claims.ResourceAccess {
+		t.ClientRoles[client] = access.Roles
+	}
+
+	return json.Unmarshal(data, &t.Claims)
+}
+
+// Claim returns the claim of the token, a name with dots like address.country is looked up as nested claim
+// the way protocol mappers nest the claims they add
+func (t *ExampleToken) Claim(name string) (interface{}, bool) {
+	if value, ok := t.Claims[name]; ok {
+		return value, true
+	}
+
+	var current interface{} = map[string]interface{}(t.Claims)
+	for _, part := range strings.Split(name, ".") {
+		claims, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if current, ok = claims[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// GetEvaluatedProtocolMappers returns the protocol mappers applied to the tokens of a client requested with the scope
+func (g *GoKeycloak) GetEvaluatedProtocolMappers(ctx context.Context, token, realm, idOfClient, scope string) (int, []*ProtocolMapperEvaluationRepresentation, error) {
+	const errMessage = "could not get evaluated protocol mappers"
+
+	var result []*ProtocolMapperEvaluationRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("scope", scope).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "evaluate-scopes", "protocol-mappers"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// GetEvaluatedGrantedRealmRoles returns the realm roles a token of a client requested with the scope may contain
+func (g *GoKeycloak) GetEvaluatedGrantedRealmRoles(ctx context.Context, token, realm, idOfClient, scope string) (int, []*Role, error) {
+	// Keycloak takes the realm name as role container of the realm roles
+	return g.getEvaluatedGrantedRoles(ctx, token, realm, idOfClient, realm, scope)
+}
+
+// GetEvaluatedGrantedClientRoles returns the roles of the role client a token of a client requested with the scope may contain
+func (g *GoKeycloak) GetEvaluatedGrantedClientRoles(ctx context.Context, token, realm, idOfClient, idOfRoleClient, scope string) (int, []*Role, error) {
+	return g.getEvaluatedGrantedRoles(ctx, token, realm, idOfClient, idOfRoleClient, scope)
+}
+
+func (g *GoKeycloak) getEvaluatedGrantedRoles(ctx context.Context, token, realm, idOfClient, roleContainerID, scope string) (int, []*Role, error) {
+	const errMessage = "could not get evaluated granted roles"
+
+	var result []*Role
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("scope", scope).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "evaluate-scopes", "scope-mappings", roleContainerID, "granted"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// GenerateExampleAccessToken returns the claims of an access token the client would get for the user and the scope
+func (g *GoKeycloak) GenerateExampleAccessToken(ctx context.Context, token, realm, idOfClient, userID, scope string) (int, *ExampleToken, error) {
+	return g.generateExampleToken(ctx, token, realm, idOfClient, "generate-example-access-token", userID, scope)
+}
+
+// GenerateExampleIDToken returns the claims of an id token the client would get for the user and the scope
+func (g *GoKeycloak) GenerateExampleIDToken(ctx context.Context, token, realm, idOfClient, userID, scope string) (int, *ExampleToken, error) {
+	return g.generateExampleToken(ctx, token, realm, idOfClient, "generate-example-id-token", userID, scope)
+}
+
+// GenerateExampleUserInfo returns the claims of the userinfo the client would get for the user and the scope
+func (g *GoKeycloak) GenerateExampleUserInfo(ctx context.Context, token, realm, idOfClient, userID, scope string) (int, *ExampleToken, error) {
+	return g.generateExampleToken(ctx, token, realm, idOfClient, "generate-example-userinfo", userID, scope)
+}
+
+func (g *GoKeycloak) generateExampleToken(ctx context.Context, token, realm, idOfClient, kind, userID, scope string) (int, *ExampleToken, error) {
+	const errMessage = "could not generate example token"
+
+	var result ExampleToken
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParams(map[string]string{
+			"userId": userID,
+			"scope":  scope,
+		}).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "evaluate-scopes", kind))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// ScopeEvaluation is the result of EvaluateClientScopes
+type ScopeEvaluation struct {
+	Scope       string
+	UserID      string
+	Mappers     []*ProtocolMapperEvaluationRepresentation
+	RealmRoles  []*Role
+	AccessToken *ExampleToken
+	IDToken     *ExampleToken
+	UserInfo    *ExampleToken
+}
+
+// EvaluateClientScopes returns the protocol mappers, granted realm roles and example tokens of a client
+// requested by the user with the scope, e.g. "openid email"
+func (g *GoKeycloak) EvaluateClientScopes(ctx context.Context, token, realm, idOfClient, userID, scope string) (*ScopeEvaluation, error) {
+	result := &ScopeEvaluation{Scope: scope, UserID: userID}
+
+	var err error
+	if _, result.Mappers, err = g.GetEvaluatedProtocolMappers(ctx, token, realm, idOfClient, scope); err != nil {
+		return nil, err
+	}
+	if _, result.RealmRoles, err = g.GetEvaluatedGrantedRealmRoles(ctx, token, realm, idOfClient, scope); err != nil {
+		return nil, err
+	}
+	if _, result.AccessToken, err = g.GenerateExampleAccessToken(ctx, token, realm, idOfClient, userID, scope); err != nil {
+		return nil, err
+	}
+	if _, result.IDToken, err = g.GenerateExampleIDToken(ctx, token, realm, idOfClient, userID, scope); err != nil {
+		return nil, err
+	}
+	if _, result.UserInfo, err = g.GenerateExampleUserInfo(ctx, token, realm, idOfClient, userID, scope); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Explain describes which client scope or client contributed each protocol mapper and which roles are granted
+func (e *ScopeEvaluation) Explain() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "scope %q for user %s\n", e.Scope, e.UserID)
+	for _, mapper := range e.Mappers {
+		container := "client scope"
+		if PString(mapper.ContainerType) == "client" {
+			container = "client"
+		}
+		fmt.Fprintf(&b, "  mapper %q (%s) from %s %q\n",
+			PString(mapper.MapperName), PString(mapper.ProtocolMapper), container, PString(mapper.ContainerName))
+	}
+	roles := make([]string, 0, len(e.RealmRoles))
+	for _, role := range e.RealmRoles {
+		roles = append(roles, PString(role.Name))
+	}
+	fmt.Fprintf(&b, "  granted realm roles: %s\n", strings.Join(roles, ", "))
+	return b.String()
+}
@@ -0,0 +1,86 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+const evaluateScopesPath = "/admin/realms/test/clients/client-id/evaluate-scopes/"
+
+func evaluateScopes(t *testing.T) (*fakeServer, *gokeycloak.ScopeEvaluation) {
+	t.Helper()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch strings.TrimPrefix(r.URL.Path, evaluateScopesPath) {
+		case "protocol-mappers":
+			_, _ = w.Write([]byte(`[
+				{"mapperId": "1", "mapperName": "email", "containerName": "email", "containerType": "client-scope", "protocolMapper": "oidc-usermodel-property-mapper"},
+				{"mapperId": "2", "mapperName": "tenant", "containerName": "app", "containerType": "client", "protocolMapper": "oidc-hardcoded-claim-mapper"}
+			]`))
+		case "scope-mappings/test/granted":
+			_, _ = w.Write([]byte(`[{"name": "offline_access"}, {"name": "user"}]`))
+		case "generate-example-access-token":
+			_, _ = w.Write([]byte(`{"sub": "user-id", "aud": "account", "scope": "openid email", "email": "jane@example.com",
+				"realm_access": {"roles": ["user"]}, "resource_access": {"account": {"roles": ["view-profile"]}},
+				"tenant": {"id": "acme"}, "address.country": "NL"}`))
+		case "generate-example-id-token":
+			_, _ = w.Write([]byte(`{"sub": "user-id", "aud": ["app", "other"], "preferred_username": "jane"}`))
+		case "generate-example-userinfo":
+			_, _ = w.Write([]byte(`{"sub": "user-id", "email": "jane@example.com"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	evaluation, err := server.client().EvaluateClientScopes(context.Background(), "token", "test", "client-id", "user-id", "openid email")
+	require.NoError(t, err)
+	return server, evaluation
+}
+
+func Test_EvaluateClientScopes(t *testing.T) {
+	t.Parallel()
+
+	server, evaluation := evaluateScopes(t)
+
+	for _, request := range server.recorded() {
+		require.Equal(t, "openid email", request.Query.Get("scope"), request.String())
+	}
+	request := server.lastRequest(t, http.MethodGet, evaluateScopesPath+"generate-example-access-token")
+	require.Equal(t, "user-id", request.Query.Get("userId"))
+
+	require.Equal(t, `scope "openid email" for user user-id
+  mapper "email" (oidc-usermodel-property-mapper) from client scope "email"
+  mapper "tenant" (oidc-hardcoded-claim-mapper) from client "app"
+  granted realm roles: offline_access, user
+`, evaluation.Explain())
+}
+
+func Test_EvaluateClientScopes_Tokens(t *testing.T) {
+	t.Parallel()
+
+	_, evaluation := evaluateScopes(t)
+
+	access := evaluation.AccessToken
+	require.Equal(t, []string{"account"}, access.Audience)
+	require.Equal(t, []string{"user"}, access.RealmRoles)
+	require.Equal(t, map[string][]string{"account": {"view-profile"}}, access.ClientRoles)
+	tenant, ok := access.Claim("tenant.id")
+	require.True(t, ok)
+	require.Equal(t, "acme", tenant)
+	country, ok := access.Claim("address.country")
+	require.True(t, ok, "claim names with dots may be flat")
+	require.Equal(t, "NL", country)
+	_, ok = access.Claim("tenant.name")
+	require.False(t, ok)
+
+	require.Equal(t, []string{"app", "other"}, evaluation.IDToken.Audience)
+	require.Equal(t, "jane", evaluation.IDToken.PreferredUsername)
+	require.Equal(t, "jane@example.com", evaluation.UserInfo.Email)
+}
@@ -2,6 +2,7 @@ package gokeycloak
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/pkg/errors"
 )
@@ -10,7 +11,7 @@ import (
 func (g *GoKeycloak) GetEvents(ctx context.Context, token string, realm string, params GetEventsParams) ([]*EventRepresentation, error) {
 	const errMessage = "could not get events"
 
-	queryParams, err := GetQueryParams(params)
+	queryParams, err := GetQueryValues(params)
 	if err != nil {
 		return nil, errors.Wrap(err, errMessage)
 	}
@@ -18,7 +19,7 @@ func (g *GoKeycloak) GetEvents(ctx context.Context, token string, realm string,
 	var result []*EventRepresentation
 	resp, err := g.GetRequestWithBearerAuth(ctx, token).
 		SetResult(&result).
-		SetQueryParams(queryParams).
+		SetQueryParamsFromValues(queryParams).
 		Get(g.getAdminRealmURL(realm, "events"))
 
 	if err := checkForError(resp, err, errMessage); err != nil {
@@ -27,3 +28,72 @@ func (g *GoKeycloak) GetEvents(ctx context.Context, token string, realm string,
 
 	return result, nil
 }
+
+// DeleteEvents deletes all login events of the realm
+func (g *GoKeycloak) DeleteEvents(ctx context.Context, token, realm string) (int, error) {
+	const errMessage = "could not delete events"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "events"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetAdminEvents returns the admin events of the realm
+func (g *GoKeycloak) GetAdminEvents(ctx context.Context, token, realm string, params GetAdminEventsParams) (int, []*AdminEventRepresentation, error) {
+	const errMessage = "could not get admin events"
+
+	queryParams, err := GetQueryValues(params)
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.Wrap(err, errMessage)
+	}
+
+	var result []*AdminEventRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParamsFromValues(queryParams).
+		Get(g.getAdminRealmURL(realm, "admin-events"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// DeleteAdminEvents deletes all admin events of the realm
+func (g *GoKeycloak) DeleteAdminEvents(ctx context.Context, token, realm string) (int, error) {
+	const errMessage = "could not delete admin events"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "admin-events"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetEventsConfig returns the events configuration of the realm
+func (g *GoKeycloak) GetEventsConfig(ctx context.Context, token, realm string) (int, *RealmEventsConfigRepresentation, error) {
+	const errMessage = "could not get events config"
+
+	var result RealmEventsConfigRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "events", "config"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// UpdateEventsConfig updates the events configuration of the realm, e.g. to enable admin events or set the listeners
+func (g *GoKeycloak) UpdateEventsConfig(ctx context.Context, token, realm string, config RealmEventsConfigRepresentation) (int, error) {
+	const errMessage = "could not update events config"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(config).
+		Put(g.getAdminRealmURL(realm, "events", "config"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
@@ -0,0 +1,52 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_GetAdminEvents(t *testing.T) {
+	cfg := GetConfig(t)
+	client := NewClientWithDebug(t)
+	token := GetAdminToken(t, client)
+	ctx := context.Background()
+
+	_, config, err := client.GetEventsConfig(ctx, token.AccessToken, cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "GetEventsConfig failed")
+	defer func() {
+		_, err := client.UpdateEventsConfig(ctx, token.AccessToken, cfg.GoKeycloak.Realm, *config)
+		require.NoError(t, err, "UpdateEventsConfig failed")
+	}()
+
+	_, err = client.UpdateEventsConfig(ctx, token.AccessToken, cfg.GoKeycloak.Realm, gokeycloak.RealmEventsConfigRepresentation{
+		AdminEventsEnabled:        gokeycloak.BoolP(true),
+		AdminEventsDetailsEnabled: gokeycloak.BoolP(true),
+		EventsListeners:           config.EventsListeners,
+	})
+	require.NoError(t, err, "UpdateEventsConfig failed")
+
+	tearDown, userID := CreateUser(t, client)
+	defer tearDown()
+
+	_, events, err := client.GetAdminEvents(ctx, token.AccessToken, cfg.GoKeycloak.Realm, gokeycloak.GetAdminEventsParams{
+		OperationTypes: []string{"CREATE"},
+		ResourceTypes:  []string{"USER"},
+		ResourcePath:   gokeycloak.StringP("users/" + userID),
+	})
+	require.NoError(t, err, "GetAdminEvents failed")
+	require.Len(t, events, 1)
+	require.Equal(t, "CREATE", gokeycloak.PString(events[0].OperationType))
+
+	var user gokeycloak.User
+	require.NoError(t, events[0].UnmarshalRepresentation(&user))
+	require.NotNil(t, user.Username)
+
+	_, err = client.DeleteAdminEvents(ctx, token.AccessToken, cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "DeleteAdminEvents failed")
+	_, err = client.DeleteEvents(ctx, token.AccessToken, cfg.GoKeycloak.Realm)
+	require.NoError(t, err, "DeleteEvents failed")
+}
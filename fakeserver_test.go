@@ -0,0 +1,153 @@
+package gokeycloak_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeRequest is a request received by a fakeServer
+type fakeRequest struct {
+	Method string
+	// URI is the path with the query, e.g. /admin/realms/test/users?max=10
+	URI    string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// String returns the method and the URI of the request
+func (r fakeRequest) String() string {
+	return r.Method + " " + r.URI
+}
+
+// fakeServer stands in for Keycloak in the offline tests. It records the requests, so the tests assert on
+// them in the test body: require stops a test only if it is called from the test goroutine, not from a handler.
+type fakeServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []fakeRequest
+}
+
+// newFakeServer starts a fakeServer which passes the requests to handler, it is closed with the test
+func newFakeServer(t *testing.T, handler http.HandlerFunc) *fakeServer {
+	t.Helper()
+
+	f := &fakeServer{}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		f.mu.Lock()
+		f.requests = append(f.requests, fakeRequest{
+			Method: r.Method,
+			URI:    r.URL.RequestURI(),
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Header: r.Header.Clone(),
+			Body:   body,
+		})
+		f.mu.Unlock()
+
+		handler(w, r)
+	}))
+	t.Cleanup(f.Close)
+
+	return f
+}
+
+// client returns a GoKeycloak which sends its requests to the server
+func (f *fakeServer) client() *gokeycloak.GoKeycloak {
+	return gokeycloak.NewClient(f.URL)
+}
+
+// recorded returns the requests received so far
+func (f *fakeServer) recorded() []fakeRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]fakeRequest{}, f.requests...)
+}
+
+// calls returns the method and the URI of the requests received so far
+func (f *fakeServer) calls() []string {
+	var calls []string
+	for _, r := range f.recorded() {
+		calls = append(calls, r.String())
+	}
+	return calls
+}
+
+// reset forgets the requests received so far
+func (f *fakeServer) reset() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.requests = nil
+}
+
+// lastRequest returns the last request with the given method and path and fails the test if there is none
+func (f *fakeServer) lastRequest(t *testing.T, method, path string) fakeRequest {
+	t.Helper()
+
+	requests := f.recorded()
+	for i := len(requests) - 1; i >= 0; i-- {
+		if requests[i].Method == method && requests[i].Path == path {
+			return requests[i]
+		}
+	}
+	require.Failf(t, "request not received", "%s %s", method, path)
+	return fakeRequest{}
+}
+
+// jsonResponses returns a handler which answers with the JSON of responses, keyed by the method and the URI of
+// the request, e.g. "GET /admin/realms/test/users?max=10". Other requests are answered with 404.
+func jsonResponses(responses map[string]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response, ok := responses[r.Method+" "+r.URL.RequestURI()]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if response == "" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(response))
+	}
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// pageBounds returns the bounds of the page selected by the first and max query parameters in a list of n items
+func pageBounds(r *http.Request, n int) (int, int) {
+	first, _ := strconv.Atoi(r.URL.Query().Get("first"))
+	max, err := strconv.Atoi(r.URL.Query().Get("max"))
+	if err != nil {
+		max = n
+	}
+	if first > n {
+		first = n
+	}
+	end := first + max
+	if end > n {
+		end = n
+	}
+	return first, end
+}
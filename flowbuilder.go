@@ -0,0 +1,456 @@
+package gokeycloak
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ExecutionRequirement is the requirement of an execution within its authentication flow
+type ExecutionRequirement string
+
+// ExecutionRequirement values
+const (
+	ExecutionRequired    ExecutionRequirement = "REQUIRED"
+	ExecutionAlternative ExecutionRequirement = "ALTERNATIVE"
+	ExecutionConditional ExecutionRequirement = "CONDITIONAL"
+	ExecutionDisabled    ExecutionRequirement = "DISABLED"
+)
+
+// FlowBuilder declares an authentication flow with its executions and sub flows, e.g.
+//
+//	flow := NewFlowBuilder("browser-otp").
+//		Execution("auth-cookie", ExecutionAlternative).
+//		SubFlow(NewFlowBuilder("browser-otp forms").
+//			Execution("auth-username-password-form", ExecutionRequired).
+//			SubFlow(NewFlowBuilder("browser-otp conditional otp").
+//				Execution("conditional-user-configured", ExecutionRequired).
+//				Execution("auth-otp-form", ExecutionRequired), ExecutionConditional), ExecutionAlternative)
+//
+// The flow is created or brought in line with the declaration by ApplyAuthenticationFlow.
+// Flow aliases are unique within a realm, so sub flows need aliases of their own.
+type FlowBuilder struct {
+	alias       string
+	description string
+	formFlow    bool
+	executions  []flowBuilderExecution
+}
+
+type flowBuilderExecution struct {
+	authenticator string
+	subFlow       *FlowBuilder
+	requirement   ExecutionRequirement
+	config        *AuthenticatorConfigRepresentation
+}
+
+// FlowDrift is a difference between a declared flow and the flow in the realm
+type FlowDrift struct {
+	// Path is the alias of the flow followed by the sub flow aliases and the authenticator, separated by slashes
+	Path string
+	// Field is one of flow, description, executions, requirement or config
+	Field   string
+	Desired string
+	Current string
+}
+
+// NewFlowBuilder starts the declaration of a flow with the given alias
+func NewFlowBuilder(alias string) *FlowBuilder {
+	return &FlowBuilder{alias: alias}
+}
+
+// Description sets the description of the flow
+func (b *FlowBuilder) Description(description string) *FlowBuilder {
+	b.description = description
+	return b
+}
+
+// FormFlow declares a sub flow as form flow, whose executions are form actions, e.g. of the registration form
+func (b *FlowBuilder) FormFlow() *FlowBuilder {
+	b.formFlow = true
+	return b
+}
+
+// Execution adds an execution of the authenticator
+func (b *FlowBuilder) Execution(authenticator string, requirement ExecutionRequirement) *FlowBuilder {
+	b.executions = append(b.executions, flowBuilderExecution{authenticator: authenticator, requirement: requirement})
+	return b
+}
+
+// ExecutionWithConfig adds an execution of the authenticator with an authenticator config.
+// Config aliases are unique within a realm.
+func (b *FlowBuilder) ExecutionWithConfig(authenticator string, requirement ExecutionRequirement, configAlias string, config map[string]string) *FlowBuilder {
+	b.executions = append(b.executions, flowBuilderExecution{
+		authenticator: authenticator,
+		requirement:   requirement,
+		config:        &AuthenticatorConfigRepresentation{Alias: StringP(configAlias), Config: &config},
+	})
+	return b
+}
+
+// SubFlow adds a sub flow
+func (b *FlowBuilder) SubFlow(flow *FlowBuilder, requirement ExecutionRequirement) *FlowBuilder {
+	b.executions = append(b.executions, flowBuilderExecution{subFlow: flow, requirement: requirement})
+	return b
+}
+
+// Representations returns the flow followed by its sub flows as they appear in a realm export,
+// so a declared flow can also be used with ReconcileRealm
+func (b *FlowBuilder) Representations() []AuthenticationFlowRepresentation {
+	return b.representations(true, nil)
+}
+
+func (b *FlowBuilder) representations(topLevel bool, result []AuthenticationFlowRepresentation) []AuthenticationFlowRepresentation {
+	flow := AuthenticationFlowRepresentation{
+		Alias:      StringP(b.alias),
+		ProviderID: StringP(b.providerID()),
+		TopLevel:   BoolP(topLevel),
+		BuiltIn:    BoolP(false),
+	}
+	if b.description != "" {
+		flow.Description = StringP(b.description)
+	}
+	executions := make([]AuthenticationExecutionRepresentation, 0, len(b.executions))
+	for i, e := range b.executions {
+		execution := AuthenticationExecutionRepresentation{
+			Requirement: StringP(string(e.requirement)),
+			Priority:    IntP((i + 1) * 10),
+		}
+		if e.subFlow != nil {
+			execution.AuthenticatorFlow = BoolP(true)
+			execution.FlowAlias = StringP(e.subFlow.alias)
+			if e.subFlow.formFlow {
+				execution.Authenticator = StringP("registration-page-form")
+			}
+		} else {
+			execution.Authenticator = StringP(e.authenticator)
+			execution.AuthenticatorFlow = BoolP(false)
+		}
+		if e.config != nil {
+			execution.AuthenticatorConfig = e.config.Alias
+		}
+		executions = append(executions, execution)
+	}
+	flow.AuthenticationExecutions = &executions
+
+	result = append(result, flow)
+	for _, e := range b.executions {
+		if e.subFlow != nil {
+			result = e.subFlow.representations(false, result)
+		}
+	}
+	return result
+}
+
+func (b *FlowBuilder) providerID() string {
+	if b.formFlow {
+		return "form-flow"
+	}
+	return "basic-flow"
+}
+
+// validate checks that the flow has an alias and all flow aliases are unique
+func (b *FlowBuilder) validate() error {
+	aliases := map[string]bool{}
+	var check func(*FlowBuilder) error
+	check = func(flow *FlowBuilder) error {
+		if flow.alias == "" {
+			return errors.New("authentication flow without alias")
+		}
+		if aliases[flow.alias] {
+			return errors.Errorf("authentication flow alias %s is used twice", flow.alias)
+		}
+		aliases[flow.alias] = true
+		for _, e := range flow.executions {
+			if e.subFlow != nil {
+				if err := check(e.subFlow); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return check(b)
+}
+
+// flowNode is an execution of a flow, flattened in the order GetAuthenticationExecutions lists executions
+type flowNode struct {
+	path        string
+	parent      string
+	level       int
+	key         string
+	subFlow     bool
+	requirement string
+	// desired nodes hold the declared config, current nodes the id of the execution and its config
+	config   *AuthenticatorConfigRepresentation
+	id       string
+	configID string
+	// keepConfig is set for desired nodes whose config is not managed
+	keepConfig bool
+}
+
+func (n flowNode) structure() string {
+	return strings.Repeat("  ", n.level) + n.key
+}
+
+// desiredNodes flattens the declared flow
+func (b *FlowBuilder) desiredNodes(path string, level int, result []flowNode) []flowNode {
+	for _, e := range b.executions {
+		node := flowNode{parent: b.alias, level: level, requirement: string(e.requirement), config: e.config}
+		if e.subFlow != nil {
+			node.key, node.subFlow = e.subFlow.alias, true
+		} else {
+			node.key = e.authenticator
+		}
+		node.path = path + "/" + node.key
+		result = append(result, node)
+		if e.subFlow != nil {
+			result = e.subFlow.desiredNodes(node.path, level+1, result)
+		}
+	}
+	return result
+}
+
+// currentNodes flattens the executions of the flow in the realm
+func currentNodes(alias string, executions []*ModifyAuthenticationExecutionRepresentation) []flowNode {
+	parents := []string{alias}
+	paths := []string{alias}
+	result := make([]flowNode, 0, len(executions))
+	for _, execution := range executions {
+		level := PInt(execution.Level)
+		if level >= len(parents) {
+			// executions are listed depth first, so a deeper level always follows its sub flow
+			level = len(parents) - 1
+		}
+		parents, paths = parents[:level+1], paths[:level+1]
+
+		node := flowNode{
+			parent:      parents[level],
+			level:       level,
+			subFlow:     PBool(execution.AuthenticationFlow),
+			requirement: PString(execution.Requirement),
+			id:          PString(execution.ID),
+			configID:    PString(execution.AuthenticationConfig),
+		}
+		if node.subFlow {
+			node.key = PString(execution.DisplayName)
+		} else {
+			node.key = PString(execution.ProviderID)
+		}
+		node.path = paths[level] + "/" + node.key
+		result = append(result, node)
+		if node.subFlow {
+			parents, paths = append(parents, node.key), append(paths, node.path)
+		}
+	}
+	return result
+}
+
+func sameStructure(desired, current []flowNode) bool {
+	if len(desired) != len(current) {
+		return false
+	}
+	for i := range desired {
+		if desired[i].structure() != current[i].structure() || desired[i].subFlow != current[i].subFlow {
+			return false
+		}
+	}
+	return true
+}
+
+func nodesStructure(nodes []flowNode) string {
+	lines := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		lines = append(lines, node.structure())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DiffAuthenticationFlow compares the declared flow with the flow of the same alias in the realm. Nothing is changed.
+// If the executions or sub flows differ, a single executions drift is returned, as requirements
+// and configs can only be compared once the structure matches.
+func (g *GoKeycloak) DiffAuthenticationFlow(ctx context.Context, token, realm string, flow *FlowBuilder) ([]FlowDrift, error) {
+	return g.syncAuthenticationFlow(ctx, token, realm, flow, false)
+}
+
+// ApplyAuthenticationFlow creates the declared flow or fixes the drift of the flow of the same alias in the realm
+// and returns the drift it fixed. Applying an unchanged declaration again changes nothing.
+// Requirements and configs are fixed in place. If executions or sub flows were added, removed or reordered,
+// the declared flow is built next to the current one, which is replaced by it in the flow bindings of the realm,
+// the clients and the identity providers and then deleted.
+func (g *GoKeycloak) ApplyAuthenticationFlow(ctx context.Context, token, realm string, flow *FlowBuilder) ([]FlowDrift, error) {
+	return g.syncAuthenticationFlow(ctx, token, realm, flow, true)
+}
+
+func (g *GoKeycloak) syncAuthenticationFlow(ctx context.Context, token, realm string, flow *FlowBuilder, apply bool) ([]FlowDrift, error) {
+	if err := flow.validate(); err != nil {
+		return nil, err
+	}
+	_, flows, err := g.GetAuthenticationFlows(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	var current *AuthenticationFlowRepresentation
+	for _, f := range flows {
+		if PString(f.Alias) == flow.alias {
+			current = f
+		}
+	}
+
+	desired := flow.desiredNodes(flow.alias, 0, nil)
+	representations := flow.Representations()
+	run := &reconcileRun{g: g, token: token, realm: realm}
+	drift := []FlowDrift{}
+
+	if current == nil {
+		drift = append(drift, FlowDrift{Path: flow.alias, Field: "flow", Desired: nodesStructure(desired)})
+		if !apply {
+			return drift, nil
+		}
+		if err := run.createFlow(ctx, representations[0], flowsByAlias(representations), nodeConfigs(desired)); err != nil {
+			return nil, err
+		}
+		return drift, nil
+	}
+	if PBool(current.BuiltIn) {
+		return nil, errors.Errorf("authentication flow %s is built in, copy it with CopyAuthenticationFlow", flow.alias)
+	}
+
+	if PString(current.Description) != flow.description {
+		drift = append(drift, FlowDrift{Path: flow.alias, Field: "description", Desired: flow.description, Current: PString(current.Description)})
+		if apply {
+			updated := *current
+			updated.Description, updated.AuthenticationExecutions = StringP(flow.description), nil
+			if _, _, err := g.UpdateAuthenticationFlow(ctx, token, realm, updated, PString(current.ID)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	_, executions, err := g.GetAuthenticationExecutions(ctx, token, realm, flow.alias)
+	if err != nil {
+		return nil, err
+	}
+	if nodes := currentNodes(flow.alias, executions); !sameStructure(desired, nodes) {
+		drift = append(drift, FlowDrift{Path: flow.alias, Field: "executions", Desired: nodesStructure(desired), Current: nodesStructure(nodes)})
+		if !apply {
+			return drift, nil
+		}
+		// executions can not be moved between sub flows, so the flow is replaced
+		return drift, run.replaceFlow(ctx, PString(current.ID), representations[0], flowsByAlias(representations), nodeConfigs(desired))
+	}
+
+	executionDrift, err := g.syncExecutions(ctx, token, realm, flow.alias, desired, apply)
+	if err != nil {
+		return nil, err
+	}
+	return append(drift, executionDrift...), nil
+}
+
+// nodeConfigs returns the configs of the nodes by alias
+func nodeConfigs(nodes []flowNode) map[string]AuthenticatorConfigRepresentation {
+	configs := map[string]AuthenticatorConfigRepresentation{}
+	for _, node := range nodes {
+		if node.config != nil {
+			configs[PString(node.config.Alias)] = *node.config
+		}
+	}
+	return configs
+}
+
+// syncExecutions compares the requirements and configs of the executions of a flow whose structure matches the declaration
+func (g *GoKeycloak) syncExecutions(ctx context.Context, token, realm, alias string, desired []flowNode, apply bool) ([]FlowDrift, error) {
+	_, executions, err := g.GetAuthenticationExecutions(ctx, token, realm, alias)
+	if err != nil {
+		return nil, err
+	}
+	current := currentNodes(alias, executions)
+	if !sameStructure(desired, current) {
+		return nil, errors.Errorf("authentication flow %s changed concurrently", alias)
+	}
+
+	drift := []FlowDrift{}
+	for i, want := range desired {
+		have, execution := current[i], executions[i]
+		if want.requirement != "" && want.requirement != have.requirement {
+			drift = append(drift, FlowDrift{Path: want.path, Field: "requirement", Desired: want.requirement, Current: have.requirement})
+			if apply {
+				execution.Requirement = StringP(want.requirement)
+				if _, err := g.UpdateAuthenticationExecution(ctx, token, realm, have.parent, *execution); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if want.keepConfig {
+			continue
+		}
+		configDrift, err := g.syncAuthenticatorConfig(ctx, token, realm, want, have, apply)
+		if err != nil {
+			return nil, err
+		}
+		if configDrift != nil {
+			drift = append(drift, *configDrift)
+		}
+	}
+
+	return drift, nil
+}
+
+func (g *GoKeycloak) syncAuthenticatorConfig(ctx context.Context, token, realm string, want, have flowNode, apply bool) (*FlowDrift, error) {
+	var currentConfig *AuthenticatorConfigRepresentation
+	if have.configID != "" {
+		var err error
+		if _, currentConfig, err = g.GetAuthenticatorConfig(ctx, token, realm, have.configID); err != nil {
+			return nil, err
+		}
+	}
+	if sameAuthenticatorConfig(want.config, currentConfig) {
+		return nil, nil
+	}
+
+	drift := &FlowDrift{Path: want.path, Field: "config", Desired: authenticatorConfigString(want.config), Current: authenticatorConfigString(currentConfig)}
+	if !apply {
+		return drift, nil
+	}
+	var err error
+	switch {
+	case want.config == nil:
+		_, err = g.DeleteAuthenticatorConfig(ctx, token, realm, have.configID)
+	case currentConfig == nil:
+		_, _, err = g.CreateAuthenticatorConfig(ctx, token, realm, have.id, *want.config)
+	default:
+		updated := *want.config
+		updated.ID = currentConfig.ID
+		_, err = g.UpdateAuthenticatorConfig(ctx, token, realm, updated)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return drift, nil
+}
+
+func sameAuthenticatorConfig(desired, current *AuthenticatorConfigRepresentation) bool {
+	if desired == nil || current == nil {
+		return desired == nil && current == nil
+	}
+	if PString(desired.Alias) != PString(current.Alias) {
+		return false
+	}
+	desiredValues, currentValues := map[string]string{}, map[string]string{}
+	if desired.Config != nil {
+		desiredValues = *desired.Config
+	}
+	if current.Config != nil {
+		currentValues = *current.Config
+	}
+	return reflect.DeepEqual(desiredValues, currentValues)
+}
+
+func authenticatorConfigString(config *AuthenticatorConfigRepresentation) string {
+	if config == nil {
+		return ""
+	}
+	return canonicalJSON(AuthenticatorConfigRepresentation{Alias: config.Alias, Config: config.Config})
+}
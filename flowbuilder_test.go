@@ -0,0 +1,409 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeFlows keeps authentication flows in memory like Keycloak's authentication management endpoints.
+// Like in Keycloak, executions, realm flow bindings and client flow overrides refer to flows by id.
+type fakeFlows struct {
+	mu    sync.Mutex
+	flows []*gokeycloak.AuthenticationFlowRepresentation
+	// executions are keyed by the id of their flow
+	executions map[string][]*fakeExecution
+	configs    map[string]*gokeycloak.AuthenticatorConfigRepresentation
+	// bindings maps the realm flow bindings, e.g. browserFlow, to flow ids
+	bindings map[string]string
+	// clients are admin representations of clients, their flow overrides are flow ids
+	clients []map[string]interface{}
+	ids     int
+}
+
+// fakeExecution is an execution of an authenticator or, if subFlow is set, of the sub flow with that id
+type fakeExecution struct {
+	id, provider, subFlow, requirement, config string
+}
+
+func newFakeFlows() *fakeFlows {
+	return &fakeFlows{
+		executions: map[string][]*fakeExecution{},
+		configs:    map[string]*gokeycloak.AuthenticatorConfigRepresentation{},
+		bindings:   map[string]string{},
+	}
+}
+
+func (f *fakeFlows) nextID() string {
+	f.ids++
+	return fmt.Sprintf("id-%d", f.ids)
+}
+
+// flow returns the flow with the given id or alias
+func (f *fakeFlows) flow(idOrAlias string) *gokeycloak.AuthenticationFlowRepresentation {
+	for _, flow := range f.flows {
+		if *flow.ID == idOrAlias || *flow.Alias == idOrAlias {
+			return flow
+		}
+	}
+	return nil
+}
+
+// executionsOf returns the executions of the flow with the given alias
+func (f *fakeFlows) executionsOf(alias string) []*fakeExecution {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.executions[*f.flow(alias).ID]
+}
+
+// aliasOf returns the alias of the flow with the given id, the fake is locked by the caller
+func (f *fakeFlows) aliasOf(id string) string {
+	if flow := f.flow(id); flow != nil {
+		return *flow.Alias
+	}
+	return ""
+}
+
+func (f *fakeFlows) addFlow(alias string, description interface{}, providerID string, topLevel bool) (string, bool) {
+	if f.flow(alias) != nil {
+		return "", false
+	}
+	flow := &gokeycloak.AuthenticationFlowRepresentation{
+		ID: gokeycloak.StringP(f.nextID()), Alias: gokeycloak.StringP(alias), ProviderID: gokeycloak.StringP(providerID),
+		TopLevel: gokeycloak.BoolP(topLevel), BuiltIn: gokeycloak.BoolP(false),
+	}
+	if description, ok := description.(string); ok {
+		flow.Description = &description
+	}
+	f.flows = append(f.flows, flow)
+	return *flow.ID, true
+}
+
+// representation returns the flow with its executions as Keycloak exports it
+func (f *fakeFlows) representation(flow *gokeycloak.AuthenticationFlowRepresentation) gokeycloak.AuthenticationFlowRepresentation {
+	result := *flow
+	executions := []gokeycloak.AuthenticationExecutionRepresentation{}
+	for i, e := range f.executions[*flow.ID] {
+		execution := gokeycloak.AuthenticationExecutionRepresentation{
+			Requirement: gokeycloak.StringP(e.requirement), Priority: gokeycloak.IntP(i * 10), AuthenticatorFlow: gokeycloak.BoolP(e.subFlow != ""),
+		}
+		if e.subFlow != "" {
+			execution.FlowAlias = gokeycloak.StringP(f.aliasOf(e.subFlow))
+		} else {
+			execution.Authenticator = gokeycloak.StringP(e.provider)
+		}
+		if e.config != "" {
+			execution.AuthenticatorConfig = f.configs[e.config].Alias
+		}
+		executions = append(executions, execution)
+	}
+	result.AuthenticationExecutions = &executions
+	return result
+}
+
+func (f *fakeFlows) list(flowID string, level int, result []gokeycloak.ModifyAuthenticationExecutionRepresentation) []gokeycloak.ModifyAuthenticationExecutionRepresentation {
+	for i, e := range f.executions[flowID] {
+		execution := gokeycloak.ModifyAuthenticationExecutionRepresentation{
+			ID: gokeycloak.StringP(e.id), Requirement: gokeycloak.StringP(e.requirement),
+			Level: gokeycloak.IntP(level), Index: gokeycloak.IntP(i), AuthenticationFlow: gokeycloak.BoolP(e.subFlow != ""),
+		}
+		if e.config != "" {
+			execution.AuthenticationConfig = gokeycloak.StringP(e.config)
+		}
+		if e.subFlow != "" {
+			execution.DisplayName = gokeycloak.StringP(f.aliasOf(e.subFlow))
+			execution.FlowID = gokeycloak.StringP(e.subFlow)
+		} else {
+			execution.ProviderID = gokeycloak.StringP(e.provider)
+		}
+		result = append(result, execution)
+		if e.subFlow != "" {
+			result = f.list(e.subFlow, level+1, result)
+		}
+	}
+	return result
+}
+
+func (f *fakeFlows) execution(id string) *fakeExecution {
+	for _, executions := range f.executions {
+		for _, e := range executions {
+			if e.id == id {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// deleteFlow deletes a flow with its sub flows and configs
+func (f *fakeFlows) deleteFlow(id string) {
+	for _, e := range f.executions[id] {
+		delete(f.configs, e.config)
+		if e.subFlow != "" {
+			f.deleteFlow(e.subFlow)
+		}
+	}
+	delete(f.executions, id)
+	for i, flow := range f.flows {
+		if *flow.ID == id {
+			f.flows = append(f.flows[:i:i], f.flows[i+1:]...)
+			break
+		}
+	}
+}
+
+// used reports whether the flow is bound by the realm or a client, Keycloak refuses to delete such flows
+func (f *fakeFlows) used(id string) bool {
+	for _, flowID := range f.bindings {
+		if flowID == id {
+			return true
+		}
+	}
+	for _, client := range f.clients {
+		overrides, _ := client["authenticationFlowBindingOverrides"].(map[string]interface{})
+		for _, flowID := range overrides {
+			if flowID == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (f *fakeFlows) configAliasUsed(alias string) bool {
+	for _, config := range f.configs {
+		if *config.Alias == alias {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *fakeFlows) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var body map[string]interface{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	switch realmPath := strings.TrimPrefix(r.URL.Path, "/admin/realms/test"); {
+	case realmPath == "" && r.Method == http.MethodGet:
+		realm := map[string]string{"realm": "test"}
+		for binding, id := range f.bindings {
+			realm[binding] = f.aliasOf(id)
+		}
+		writeJSON(w, realm)
+		return
+	case realmPath == "" && r.Method == http.MethodPut:
+		for binding, alias := range body {
+			if binding != "realm" {
+				f.bindings[binding] = *f.flow(alias.(string)).ID
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case realmPath == "/clients" && r.Method == http.MethodGet:
+		writeJSON(w, f.clients)
+		return
+	case realmPath == "/identity-provider/instances" && r.Method == http.MethodGet:
+		writeJSON(w, []interface{}{})
+		return
+	case strings.HasPrefix(realmPath, "/clients/") && r.Method == http.MethodPut:
+		for _, client := range f.clients {
+			if client["id"] == strings.TrimPrefix(realmPath, "/clients/") {
+				overrides, _ := client["authenticationFlowBindingOverrides"].(map[string]interface{})
+				for binding, id := range body["authenticationFlowBindingOverrides"].(map[string]interface{}) {
+					overrides[binding] = id
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, "/admin/realms/test/authentication/"), "/")
+	switch {
+	case r.Method == http.MethodGet && len(path) == 1 && path[0] == "flows":
+		flows := []gokeycloak.AuthenticationFlowRepresentation{}
+		for _, flow := range f.flows {
+			if *flow.TopLevel {
+				flows = append(flows, f.representation(flow))
+			}
+		}
+		writeJSON(w, flows)
+	case r.Method == http.MethodPost && len(path) == 1 && path[0] == "flows":
+		providerID, _ := body["providerId"].(string)
+		if _, ok := f.addFlow(body["alias"].(string), body["description"], providerID, true); !ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet && len(path) == 2 && path[0] == "flows":
+		writeJSON(w, f.representation(f.flow(path[1])))
+	case r.Method == http.MethodPut && len(path) == 2 && path[0] == "flows":
+		flow := f.flow(path[1])
+		if other := f.flow(body["alias"].(string)); other != nil && other != flow {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		flow.Alias = gokeycloak.StringP(body["alias"].(string))
+		if description, ok := body["description"].(string); ok {
+			flow.Description = &description
+		}
+		writeJSON(w, f.representation(flow))
+	case r.Method == http.MethodDelete && len(path) == 2 && path[0] == "flows":
+		if f.used(path[1]) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.deleteFlow(path[1])
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && len(path) == 3 && path[2] == "executions":
+		writeJSON(w, f.list(*f.flow(path[1]).ID, 0, []gokeycloak.ModifyAuthenticationExecutionRepresentation{}))
+	case r.Method == http.MethodPost && len(path) == 4 && path[3] == "execution":
+		id := *f.flow(path[1]).ID
+		f.executions[id] = append(f.executions[id], &fakeExecution{id: f.nextID(), provider: body["provider"].(string), requirement: "DISABLED"})
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPost && len(path) == 4 && path[3] == "flow":
+		subFlow, ok := f.addFlow(body["alias"].(string), body["description"], body["type"].(string), false)
+		if !ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		id := *f.flow(path[1]).ID
+		f.executions[id] = append(f.executions[id], &fakeExecution{id: f.nextID(), subFlow: subFlow, requirement: "DISABLED"})
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && len(path) == 3 && path[2] == "executions":
+		f.execution(body["id"].(string)).requirement = body["requirement"].(string)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && len(path) == 2 && path[0] == "executions":
+		for flowID, executions := range f.executions {
+			for i, e := range executions {
+				if e.id == path[1] {
+					f.executions[flowID] = append(executions[:i:i], executions[i+1:]...)
+					delete(f.configs, e.config)
+					if e.subFlow != "" {
+						f.deleteFlow(e.subFlow)
+					}
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && len(path) == 3 && path[2] == "config":
+		if f.configAliasUsed(body["alias"].(string)) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		id := f.nextID()
+		f.configs[id] = &gokeycloak.AuthenticatorConfigRepresentation{ID: gokeycloak.StringP(id), Alias: gokeycloak.StringP(body["alias"].(string)), Config: fakeConfig(body)}
+		f.execution(path[1]).config = id
+		w.Header().Set("Location", r.URL.String()+"/"+id)
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet && path[0] == "config":
+		writeJSON(w, f.configs[path[1]])
+	case r.Method == http.MethodPut && path[0] == "config":
+		f.configs[path[1]].Alias = gokeycloak.StringP(body["alias"].(string))
+		f.configs[path[1]].Config = fakeConfig(body)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete && path[0] == "config":
+		delete(f.configs, path[1])
+		for _, executions := range f.executions {
+			for _, e := range executions {
+				if e.config == path[1] {
+					e.config = ""
+				}
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func fakeConfig(body map[string]interface{}) *map[string]string {
+	config := map[string]string{}
+	values, _ := body["config"].(map[string]interface{})
+	for k, v := range values {
+		config[k] = v.(string)
+	}
+	return &config
+}
+
+func Test_ApplyAuthenticationFlow(t *testing.T) {
+	t.Parallel()
+
+	fake := newFakeFlows()
+	server := newFakeServer(t, fake.ServeHTTP)
+
+	client := server.client()
+	ctx := context.Background()
+
+	flow := gokeycloak.NewFlowBuilder("browser-otp").
+		Description("browser with conditional otp").
+		Execution("auth-cookie", gokeycloak.ExecutionAlternative).
+		ExecutionWithConfig("identity-provider-redirector", gokeycloak.ExecutionAlternative, "redirector", map[string]string{"defaultProvider": "corp"}).
+		SubFlow(gokeycloak.NewFlowBuilder("browser-otp forms").
+			Execution("auth-username-password-form", gokeycloak.ExecutionRequired).
+			SubFlow(gokeycloak.NewFlowBuilder("browser-otp conditional otp").
+				Execution("conditional-user-configured", gokeycloak.ExecutionRequired).
+				Execution("auth-otp-form", gokeycloak.ExecutionRequired), gokeycloak.ExecutionConditional), gokeycloak.ExecutionAlternative)
+
+	require.Len(t, flow.Representations(), 3)
+
+	drift, err := client.ApplyAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Len(t, drift, 1)
+	require.Equal(t, "flow", drift[0].Field)
+
+	drift, err = client.DiffAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Empty(t, drift, "applying a flow must be idempotent")
+
+	// drift of requirements and configs is fixed in place
+	otp := fake.executionsOf("browser-otp conditional otp")[1]
+	otp.requirement = "DISABLED"
+	(*fake.configs[fake.executionsOf("browser-otp")[1].config].Config)["defaultProvider"] = "other"
+	drift, err = client.ApplyAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.FlowDrift{
+		{Path: "browser-otp/identity-provider-redirector", Field: "config",
+			Desired: `{"alias":"redirector","config":{"defaultProvider":"corp"}}`, Current: `{"alias":"redirector","config":{"defaultProvider":"other"}}`},
+		{Path: "browser-otp/browser-otp forms/browser-otp conditional otp/auth-otp-form", Field: "requirement", Desired: "REQUIRED", Current: "DISABLED"},
+	}, drift)
+	require.Equal(t, "REQUIRED", otp.requirement)
+	drift, err = client.DiffAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Empty(t, drift)
+
+	// changed executions replace the flow, which stays bound
+	fake.mu.Lock()
+	id := *fake.flow("browser-otp").ID
+	fake.bindings["browserFlow"] = id
+	fake.mu.Unlock()
+	flow.Execution("auth-spnego", gokeycloak.ExecutionDisabled)
+	drift, err = client.DiffAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Len(t, drift, 1)
+	require.Equal(t, "executions", drift[0].Field)
+	_, err = client.ApplyAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	drift, err = client.DiffAuthenticationFlow(ctx, "token", "test", flow)
+	require.NoError(t, err)
+	require.Empty(t, drift)
+	require.Len(t, fake.executionsOf("browser-otp"), 4)
+	fake.mu.Lock()
+	require.NotEqual(t, id, fake.bindings["browserFlow"])
+	require.Equal(t, *fake.flow("browser-otp").ID, fake.bindings["browserFlow"])
+	require.Nil(t, fake.flow(id))
+	fake.mu.Unlock()
+
+	_, err = client.ApplyAuthenticationFlow(ctx, "token", "test", gokeycloak.NewFlowBuilder("a").SubFlow(gokeycloak.NewFlowBuilder("a"), gokeycloak.ExecutionRequired))
+	require.Error(t, err)
+}
@@ -0,0 +1,100 @@
+package gokeycloak
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// CallInfo describes a call of GoKeycloakV2
+type CallInfo struct {
+	// Operation is the name of the called method, e.g. "GetUsers"
+	Operation string
+	// Realm is the realm the call operates on, empty if the call has no realm parameter
+	Realm string
+	// Header holds headers which are added to all requests of the call.
+	// Before hooks can use it to tag requests, e.g. with a tenant.
+	Header http.Header
+	// Started is the time the call started
+	Started time.Time
+}
+
+// BeforeCallHook is called before a call is made
+type BeforeCallHook func(ctx context.Context, call *CallInfo)
+
+// AfterCallHook is called after a call returned.
+// resp is nil if no request was sent.
+type AfterCallHook func(ctx context.Context, call *CallInfo, resp *Response, err error)
+
+// AddBeforeCallHook adds a hook which is called before every call of GoKeycloakV2, see GoKeycloak.V2.
+// A call runs the hooks once, however many requests it sends. Calls of GoKeycloak do not run the hooks.
+func AddBeforeCallHook(hook BeforeCallHook) func(g *GoKeycloak) {
+	return func(g *GoKeycloak) {
+		g.beforeHooks = append(g.beforeHooks, hook)
+	}
+}
+
+// AddAfterCallHook adds a hook which is called after every call, see AddBeforeCallHook
+func AddAfterCallHook(hook AfterCallHook) func(g *GoKeycloak) {
+	return func(g *GoKeycloak) {
+		g.afterHooks = append(g.afterHooks, hook)
+	}
+}
+
+var callContextKey = contextKey("call")
+
+// call tracks a single call made through GoKeycloakV2
+type call struct {
+	g        *GoKeycloak
+	ctx      context.Context
+	info     *CallInfo
+	recorder *responseRecorder
+	cancel   context.CancelFunc
+}
+
+// beginCall runs the before hooks and returns the context the call has to be made with.
+// The timeout of the options of the client and of opts limits the whole call.
+func (g *GoKeycloak) beginCall(ctx context.Context, operation, realm string, opts []RequestOption) (context.Context, *call) {
+	info := &CallInfo{
+		Operation: operation,
+		Realm:     realm,
+		Header:    http.Header{},
+		Started:   time.Now(),
+	}
+	for _, hook := range g.beforeHooks {
+		hook(ctx, info)
+	}
+
+	hookHeader := func(o *requestOptions) {
+		for key, values := range info.Header {
+			o.header[key] = append(o.header[key], values...)
+		}
+	}
+	callOpts := []RequestOption{hookHeader}
+	if g.options != nil && g.options.timeout > 0 {
+		callOpts = append(callOpts, WithTimeout(g.options.timeout))
+	}
+	ctx, cancel := WithRequestOptions(ctx, append(callOpts, opts...)...)
+	ctx, recorder := recordResponses(ctx)
+	ctx = context.WithValue(ctx, callContextKey, info)
+
+	return ctx, &call{
+		g:        g,
+		ctx:      ctx,
+		info:     info,
+		recorder: recorder,
+		cancel:   cancel,
+	}
+}
+
+// end runs the after hooks and returns the response of the call
+func (c *call) end(err error) *Response {
+	defer c.cancel()
+
+	resp := c.recorder.response()
+	for _, hook := range c.g.afterHooks {
+		hook(c.ctx, c.info, resp, err)
+	}
+
+	return resp
+}
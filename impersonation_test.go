@@ -0,0 +1,80 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_Impersonation(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/admin/realms/test/users/allowed/impersonation":
+			http.SetCookie(w, &http.Cookie{Name: "KEYCLOAK_IDENTITY", Value: "identity", Path: "/realms/test/"})
+			_, _ = w.Write([]byte(`{"sameRealm": true, "redirect": "http://keycloak/realms/test/account"}`))
+		case "/realms/test/protocol/openid-connect/token":
+			_ = r.ParseForm()
+			if r.PostForm.Get("requested_subject") != "customer" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error": "access_denied", "error_description": "Client not allowed to exchange"}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": r.PostForm.Get("requested_token_type") + " for " + r.PostForm.Get("audience"),
+			})
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error": "unknown_error"}`))
+		}
+	})
+
+	client := server.client()
+	ctx := context.Background()
+
+	_, impersonation, err := client.ImpersonateUser(ctx, "token", "test", "allowed")
+	require.NoError(t, err)
+	require.Equal(t, "http://keycloak/realms/test/account", gokeycloak.PString(impersonation.Redirect))
+	require.Len(t, impersonation.Cookies, 1)
+	require.Equal(t, "identity", impersonation.Cookies[0].Value)
+
+	_, _, err = client.ImpersonateUser(ctx, "token", "test", "denied")
+	var apiErr *gokeycloak.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, gokeycloak.APIErrTypeImpersonationForbidden, apiErr.Type)
+
+	subjectToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":                "agent-id",
+		"preferred_username": "agent",
+	}).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	options := gokeycloak.ImpersonationOptions{
+		ClientID:         "support",
+		ClientSecret:     "secret",
+		SubjectToken:     subjectToken,
+		RequestedSubject: "customer",
+		Reason:           "ticket 42",
+	}
+
+	_, token, err := client.ImpersonateUserWithTokenExchange(ctx, "test", options)
+	require.NoError(t, err)
+	require.Equal(t, "urn:ietf:params:oauth:token-type:access_token for support", token.Token.AccessToken)
+	require.Equal(t, "agent-id", token.Audit.Impersonator)
+	require.Equal(t, "agent", token.Audit.ImpersonatorUsername)
+	require.Equal(t, "customer", token.Audit.Subject)
+	require.Equal(t, "ticket 42", token.Audit.Reason)
+
+	options.RequestedSubject = "someone else"
+	_, _, err = client.ImpersonateUserWithTokenExchange(ctx, "test", options)
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, gokeycloak.APIErrTypeImpersonationForbidden, apiErr.Type)
+}
@@ -0,0 +1,96 @@
+package gokeycloak
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------
+// Client Installations
+// ------------------------------
+
+// Provider ids of the client installations built into Keycloak, the providers of a server are listed by
+// ServerInfoRepresentation.ClientInstallations. Keycloak has no built in provider of a Kubernetes or OpenShift secret,
+// providers of extensions can be passed to GetClientInstallation by their id.
+const (
+	InstallationOIDCJSON              = "keycloak-oidc-keycloak-json"
+	InstallationOIDCJBossSubsystem    = "keycloak-oidc-jboss-subsystem"
+	InstallationOIDCJBossSubsystemCLI = "keycloak-oidc-jboss-subsystem-cli"
+	InstallationSAML                  = "keycloak-saml"
+	InstallationSAMLSubsystem         = "keycloak-saml-subsystem"
+	InstallationSAMLSubsystemCLI      = "keycloak-saml-subsystem-cli"
+	InstallationSAMLIDPDescriptor     = "saml-idp-descriptor"
+	InstallationSAMLSPDescriptor      = "saml-sp-descriptor"
+	// InstallationModAuthMellon is a zip archive of the files of the Apache mod_auth_mellon module
+	InstallationModAuthMellon = "mod-auth-mellon"
+)
+
+// ClientInstallation is the configuration of a client rendered by an installation provider
+type ClientInstallation struct {
+	ProviderID  string
+	ContentType string
+	Data        []byte
+}
+
+// GetClientInstallation returns the configuration of a client rendered by the installation provider, e.g. InstallationOIDCJSON
+func (g *GoKeycloak) GetClientInstallation(ctx context.Context, token, realm, idOfClient, providerID string) (int, *ClientInstallation, error) {
+	const errMessage = "could not get client installation"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, "installation", "providers", providerID))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &ClientInstallation{
+		ProviderID:  providerID,
+		ContentType: resp.Header().Get("Content-Type"),
+		Data:        resp.Body(),
+	}, nil
+}
+
+// AdapterConfiguration parses an installation of InstallationOIDCJSON
+func (i *ClientInstallation) AdapterConfiguration() (*AdapterConfiguration, error) {
+	var result AdapterConfiguration
+	if err := json.Unmarshal(i.Data, &result); err != nil {
+		return nil, errors.Wrapf(err, "could not parse client installation %s", i.ProviderID)
+	}
+	return &result, nil
+}
+
+// SAMLEntityDescriptor parses an installation of InstallationSAMLIDPDescriptor or InstallationSAMLSPDescriptor
+func (i *ClientInstallation) SAMLEntityDescriptor() (*SAMLEntityDescriptor, error) {
+	var result SAMLEntityDescriptor
+	if err := xml.Unmarshal(i.Data, &result); err != nil {
+		return nil, errors.Wrapf(err, "could not parse client installation %s", i.ProviderID)
+	}
+	if result.XMLName.Local != "EntityDescriptor" {
+		return nil, errors.Errorf("could not parse client installation %s: no SAML entity descriptor", i.ProviderID)
+	}
+	return &result, nil
+}
+
+// GetClientOIDCInstallation returns the OIDC JSON adapter configuration of a client
+func (g *GoKeycloak) GetClientOIDCInstallation(ctx context.Context, token, realm, idOfClient string) (int, *AdapterConfiguration, error) {
+	status, installation, err := g.GetClientInstallation(ctx, token, realm, idOfClient, InstallationOIDCJSON)
+	if err != nil {
+		return status, nil, err
+	}
+	result, err := installation.AdapterConfiguration()
+	return status, result, err
+}
+
+// GetClientSAMLDescriptor returns the SAML metadata of a client, providerID is
+// InstallationSAMLIDPDescriptor for the realm as identity provider or InstallationSAMLSPDescriptor for the client
+func (g *GoKeycloak) GetClientSAMLDescriptor(ctx context.Context, token, realm, idOfClient, providerID string) (int, *SAMLEntityDescriptor, error) {
+	status, installation, err := g.GetClientInstallation(ctx, token, realm, idOfClient, providerID)
+	if err != nil {
+		return status, nil, err
+	}
+	result, err := installation.SAMLEntityDescriptor()
+	return status, result, err
+}
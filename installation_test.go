@@ -0,0 +1,83 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+const spDescriptor = `<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://app.example.com/saml">
+  <md:SPSSODescriptor AuthnRequestsSigned="true" WantAssertionsSigned="false" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:KeyName>kid</ds:KeyName><ds:X509Data><ds:X509Certificate>MIIC</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://app.example.com/saml/logout"/>
+    <md:NameIDFormat>urn:oasis:names:tc:SAML:2.0:nameid-format:persistent</md:NameIDFormat>
+    <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://app.example.com/saml/acs" index="1" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>`
+
+func Test_GetClientInstallation(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch strings.TrimPrefix(r.URL.Path, "/admin/realms/test/clients/client-id/installation/providers/") {
+		case gokeycloak.InstallationOIDCJSON:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"realm": "test", "auth-server-url": "https://sso.example.com/", "resource": "app", "credentials": {"secret": "s3cr3t"}}`))
+		case gokeycloak.InstallationSAMLSPDescriptor:
+			w.Header().Set("Content-Type", "application/xml")
+			_, _ = w.Write([]byte(spDescriptor))
+		case gokeycloak.InstallationOIDCJBossSubsystem:
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(`<secure-deployment name="WAR MODULE NAME.war"/>`))
+		case gokeycloak.InstallationModAuthMellon:
+			w.Header().Set("Content-Type", "application/zip")
+			_, _ = w.Write([]byte("PK\x03\x04\x00\xff"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := server.client()
+	ctx := context.Background()
+
+	_, installation, err := client.GetClientInstallation(ctx, "token", "test", "client-id", gokeycloak.InstallationOIDCJBossSubsystem)
+	require.NoError(t, err)
+	require.Equal(t, "text/plain", installation.ContentType)
+	require.Equal(t, `<secure-deployment name="WAR MODULE NAME.war"/>`, string(installation.Data))
+
+	_, installation, err = client.GetClientInstallation(ctx, "token", "test", "client-id", gokeycloak.InstallationModAuthMellon)
+	require.NoError(t, err)
+	require.Equal(t, "application/zip", installation.ContentType)
+	require.Equal(t, []byte("PK\x03\x04\x00\xff"), installation.Data, "binary installations are returned unchanged")
+
+	_, config, err := client.GetClientOIDCInstallation(ctx, "token", "test", "client-id")
+	require.NoError(t, err)
+	require.Equal(t, "app", *config.Resource)
+	require.Equal(t, map[string]interface{}{"secret": "s3cr3t"}, config.Credentials)
+
+	_, descriptor, err := client.GetClientSAMLDescriptor(ctx, "token", "test", "client-id", gokeycloak.InstallationSAMLSPDescriptor)
+	require.NoError(t, err)
+	require.Equal(t, "https://app.example.com/saml", descriptor.EntityID)
+	require.Nil(t, descriptor.IDPSSODescriptor)
+	sp := descriptor.SPSSODescriptor
+	require.True(t, *sp.AuthnRequestsSigned)
+	require.Equal(t, []gokeycloak.SAMLKeyDescriptor{{Use: "signing", KeyName: "kid", Certificate: "MIIC"}}, sp.KeyDescriptors)
+	require.Equal(t, []string{"urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"}, sp.NameIDFormats)
+	require.Equal(t, "https://app.example.com/saml/acs", sp.AssertionConsumerServices[0].Location)
+	require.Equal(t, 1, *sp.AssertionConsumerServices[0].Index)
+
+	_, err = installation.SAMLEntityDescriptor()
+	require.Error(t, err)
+	_, err = installation.AdapterConfiguration()
+	require.Error(t, err)
+
+	_, _, err = client.GetClientInstallation(ctx, "token", "test", "client-id", "unknown")
+	require.Error(t, err)
+}
@@ -0,0 +1,253 @@
+// Command genv2 generates the GoKeycloakV2 wrappers of all GoKeycloak calls.
+//
+// A call is every exported method of *GoKeycloak which takes a context.Context as
+// first parameter and returns an error as last result. A leading int result is
+// the status code and is dropped, since it is part of the returned *Response.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = `// Code generated by genv2. DO NOT EDIT.
+
+package gokeycloak
+`
+
+type call struct {
+	name    string
+	params  []param
+	results []string
+	status  bool
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func main() {
+	dir := flag.String("dir", ".", "package directory")
+	output := flag.String("output", "client_v2.go", "output file name")
+	flag.Parse()
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, *dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != *output
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, ok := pkgs["gokeycloak"]
+	if !ok {
+		log.Fatalf("package gokeycloak not found in %s", *dir)
+	}
+
+	imports := map[string]string{}
+	var calls []call
+	for _, file := range pkg.Files {
+		fileImports := importPaths(file)
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !isCall(fn) {
+				continue
+			}
+			c, used := newCall(fset, fn)
+			for _, name := range used {
+				path, ok := fileImports[name]
+				if !ok {
+					log.Fatalf("%s: unknown package %s", c.name, name)
+				}
+				imports[name] = path
+			}
+			calls = append(calls, c)
+		}
+	}
+	sort.Slice(calls, func(i, j int) bool { return calls[i].name < calls[j].name })
+
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	writeImports(&buf, imports)
+	for _, c := range calls {
+		c.write(&buf)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format generated code: %v\n%s", err, buf.String())
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// isCall reports whether fn is a call of *GoKeycloak which gets a wrapper
+func isCall(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || !fn.Name.IsExported() || fn.Type.Results == nil {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok || !isIdent(star.X, "GoKeycloak") {
+		return false
+	}
+	if fn.Doc != nil && strings.Contains(fn.Doc.Text(), "Deprecated:") {
+		return false
+	}
+	params := fn.Type.Params.List
+	if len(params) == 0 || !isSelector(params[0].Type, "context", "Context") {
+		return false
+	}
+	results := fn.Type.Results.List
+	return isIdent(results[len(results)-1].Type, "error")
+}
+
+func newCall(fset *token.FileSet, fn *ast.FuncDecl) (call, []string) {
+	c := call{name: fn.Name.Name}
+	var used []string
+	typeString := func(expr ast.Expr) string {
+		ast.Inspect(expr, func(node ast.Node) bool {
+			if sel, ok := node.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					used = append(used, ident.Name)
+				}
+			}
+			return true
+		})
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, expr); err != nil {
+			log.Fatal(err)
+		}
+		return buf.String()
+	}
+
+	for _, field := range fn.Type.Params.List {
+		p := param{typ: typeString(field.Type)}
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			p.typ = typeString(ellipsis.Elt)
+			p.variadic = true
+		}
+		for _, name := range field.Names {
+			p.name = name.Name
+			c.params = append(c.params, p)
+		}
+	}
+
+	results := fn.Type.Results.List
+	for i, field := range results[:len(results)-1] {
+		if i == 0 && len(results) > 1 && isIdent(field.Type, "int") {
+			c.status = true
+			continue
+		}
+		typ := typeString(field.Type)
+		for range fieldNames(field) {
+			c.results = append(c.results, typ)
+		}
+	}
+
+	return c, used
+}
+
+func (c call) write(buf *bytes.Buffer) {
+	var params, args, results, values []string
+	realm := `""`
+	for _, p := range c.params {
+		switch {
+		case p.name == "realm" && p.typ == "string":
+			realm = p.name
+		case p.name == "realm" && p.typ == "RealmRepresentation":
+			realm = "PString(realm.Realm)"
+		}
+		// variadic parameters become slices, since the wrappers take the request options as variadic parameter
+		if p.variadic {
+			params = append(params, p.name+" []"+p.typ)
+			args = append(args, p.name+"...")
+			continue
+		}
+		params = append(params, p.name+" "+p.typ)
+		args = append(args, p.name)
+	}
+	params = append(params, "opts ...RequestOption")
+	for i := range c.results {
+		values = append(values, "res"+strconv.Itoa(i))
+	}
+	results = append(append(results, c.results...), "*Response", "error")
+
+	assign := append([]string{}, values...)
+	if c.status {
+		assign = append([]string{"_"}, assign...)
+	}
+	assign = append(assign, "err")
+
+	fmt.Fprintf(buf, "\n// %s calls GoKeycloak.%s and returns the HTTP response alongside the result\n", c.name, c.name)
+	fmt.Fprintf(buf, "func (v *GoKeycloakV2) %s(%s) (%s) {\n", c.name, strings.Join(params, ", "), strings.Join(results, ", "))
+	fmt.Fprintf(buf, "\tctx, call := v.g.beginCall(ctx, %q, %s, opts)\n", c.name, realm)
+	fmt.Fprintf(buf, "\t%s := v.g.%s(%s)\n", strings.Join(assign, ", "), c.name, strings.Join(args, ", "))
+	fmt.Fprintf(buf, "\treturn %s\n}\n", strings.Join(append(values, "call.end(err)", "err"), ", "))
+}
+
+func writeImports(buf *bytes.Buffer, imports map[string]string) {
+	var std, other []string
+	for _, path := range imports {
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, strconv.Quote(path))
+		} else {
+			std = append(std, strconv.Quote(path))
+		}
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+	groups := strings.Join(std, "\n\t")
+	if len(other) > 0 {
+		groups += "\n\n\t" + strings.Join(other, "\n\t")
+	}
+	fmt.Fprintf(buf, "\nimport (\n\t%s\n)\n", groups)
+}
+
+// importPaths maps the package names used in file to their import paths
+func importPaths(file *ast.File) map[string]string {
+	paths := map[string]string{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		} else if strings.HasPrefix(name, "v") && strings.Count(path, "/") > 1 {
+			if _, err := strconv.Atoi(name[1:]); err == nil {
+				parent := strings.TrimSuffix(path, "/"+name)
+				name = strings.TrimPrefix(parent[strings.LastIndex(parent, "/")+1:], "go-")
+			}
+		}
+		paths[name] = path
+	}
+	return paths
+}
+
+func fieldNames(field *ast.Field) []*ast.Ident {
+	if len(field.Names) == 0 {
+		return []*ast.Ident{nil}
+	}
+	return field.Names
+}
+
+func isIdent(expr ast.Expr, name string) bool {
+	ident, ok := expr.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+func isSelector(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	return ok && isIdent(sel.X, pkg) && sel.Sel.Name == name
+}
@@ -0,0 +1,307 @@
+package gokeycloak
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------
+// Realm Keys
+// ------------------------------
+
+// KeyProviderType is the providerType of the components providing the keys of a realm
+const KeyProviderType = "org.keycloak.keys.KeyProvider"
+
+// Key status values of the keys of a realm
+const (
+	// KeyStatusActive keys are used to sign and verify
+	KeyStatusActive = "ACTIVE"
+	// KeyStatusPassive keys are only used to verify
+	KeyStatusPassive = "PASSIVE"
+	// KeyStatusDisabled keys are not used at all
+	KeyStatusDisabled = "DISABLED"
+)
+
+// keyProvider creates an enabled and active key provider, empty config values are left to Keycloak's defaults
+func keyProvider(name, providerID string, priority int64, config map[string]string) Component {
+	componentConfig := map[string][]string{
+		"priority": {strconv.FormatInt(priority, 10)},
+		"enabled":  {"true"},
+		"active":   {"true"},
+	}
+	for key, value := range config {
+		if value != "" {
+			componentConfig[key] = []string{value}
+		}
+	}
+
+	return Component{
+		Name:            StringP(name),
+		ProviderID:      StringP(providerID),
+		ProviderType:    StringP(KeyProviderType),
+		ComponentConfig: &componentConfig,
+	}
+}
+
+func formatSize(size int) string {
+	if size <= 0 {
+		return ""
+	}
+	return strconv.Itoa(size)
+}
+
+// NewRSAGeneratedKeyProvider returns a provider generating a RSA key, e.g. of algorithm RS256 and key size 2048
+func NewRSAGeneratedKeyProvider(name string, priority int64, algorithm string, keySize int) Component {
+	return keyProvider(name, "rsa-generated", priority, map[string]string{"algorithm": algorithm, "keySize": formatSize(keySize)})
+}
+
+// NewECDSAGeneratedKeyProvider returns a provider generating an ECDSA key on the elliptic curve, e.g. P-256
+func NewECDSAGeneratedKeyProvider(name string, priority int64, ellipticCurve string) Component {
+	return keyProvider(name, "ecdsa-generated", priority, map[string]string{"ecdsaEllipticCurveKey": ellipticCurve})
+}
+
+// NewHMACGeneratedKeyProvider returns a provider generating a HMAC secret, e.g. of algorithm HS256 and 64 bytes
+func NewHMACGeneratedKeyProvider(name string, priority int64, algorithm string, secretSize int) Component {
+	return keyProvider(name, "hmac-generated", priority, map[string]string{"algorithm": algorithm, "secretSize": formatSize(secretSize)})
+}
+
+// NewAESGeneratedKeyProvider returns a provider generating an AES secret of 16, 24 or 32 bytes
+func NewAESGeneratedKeyProvider(name string, priority int64, secretSize int) Component {
+	return keyProvider(name, "aes-generated", priority, map[string]string{"secretSize": formatSize(secretSize)})
+}
+
+// NewRSAKeyProvider returns a provider of an imported RSA key, the private key and the optional certificate are PEM encoded
+func NewRSAKeyProvider(name string, priority int64, algorithm, privateKey, certificate string) Component {
+	return keyProvider(name, "rsa", priority, map[string]string{"algorithm": algorithm, "privateKey": privateKey, "certificate": certificate})
+}
+
+// NewJavaKeystoreKeyProvider returns a provider of a key loaded from a java keystore file on the Keycloak server
+func NewJavaKeystoreKeyProvider(name string, priority int64, algorithm, keystore, keystorePassword, keyAlias, keyPassword string) Component {
+	return keyProvider(name, "java-keystore", priority, map[string]string{
+		"algorithm":        algorithm,
+		"keystore":         keystore,
+		"keystorePassword": keystorePassword,
+		"keyAlias":         keyAlias,
+		"keyPassword":      keyPassword,
+	})
+}
+
+// KeyProviderPriority returns the priority of a key provider, keys of higher priority are used first
+func KeyProviderPriority(provider *Component) int64 {
+	if provider.ComponentConfig == nil || len((*provider.ComponentConfig)["priority"]) == 0 {
+		return 0
+	}
+	priority, _ := strconv.ParseInt((*provider.ComponentConfig)["priority"][0], 10, 64)
+	return priority
+}
+
+// GetKeyProviders returns the key providers of the realm, highest priority first
+func (g *GoKeycloak) GetKeyProviders(ctx context.Context, token, realm string) (int, []*Component, error) {
+	const errMessage = "could not get key providers"
+
+	var components []*Component
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&components).
+		SetQueryParam("type", KeyProviderType).
+		Get(g.getAdminRealmURL(realm, "components"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	result := []*Component{}
+	for _, component := range components {
+		if PString(component.ProviderType) == KeyProviderType {
+			result = append(result, component)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return KeyProviderPriority(result[i]) > KeyProviderPriority(result[j])
+	})
+
+	return resp.StatusCode(), result, nil
+}
+
+// SetKeyProviderPriority changes the priority of a key provider
+func (g *GoKeycloak) SetKeyProviderPriority(ctx context.Context, token, realm, providerID string, priority int64) (int, error) {
+	return g.updateKeyProviderConfig(ctx, token, realm, providerID, map[string]string{"priority": strconv.FormatInt(priority, 10)})
+}
+
+// SetKeyProviderState enables or disables a key provider and makes its keys active or passive
+func (g *GoKeycloak) SetKeyProviderState(ctx context.Context, token, realm, providerID string, enabled, active bool) (int, error) {
+	return g.updateKeyProviderConfig(ctx, token, realm, providerID, map[string]string{
+		"enabled": strconv.FormatBool(enabled),
+		"active":  strconv.FormatBool(active),
+	})
+}
+
+// updateKeyProviderConfig reads the key provider and updates the given config values,
+// the status code is the one of the last request
+func (g *GoKeycloak) updateKeyProviderConfig(ctx context.Context, token, realm, providerID string, config map[string]string) (int, error) {
+	const errMessage = "could not update key provider"
+
+	var provider Component
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&provider).
+		Get(g.getAdminRealmURL(realm, "components", providerID))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), err
+	}
+	if provider.ComponentConfig == nil {
+		provider.ComponentConfig = &map[string][]string{}
+	}
+	for key, value := range config {
+		(*provider.ComponentConfig)[key] = []string{value}
+	}
+
+	resp, err = g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(provider).
+		Put(g.getAdminRealmURL(realm, "components", providerID))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// KeyRotation is the result of RotateKeys
+type KeyRotation struct {
+	// ProviderID is the id of the new key provider
+	ProviderID string
+	// Algorithms are the algorithms of the new keys
+	Algorithms []string
+	// RemovedProviderIDs are the ids of the replaced key providers
+	RemovedProviderIDs []string
+}
+
+// RotateKeys replaces the active keys of the algorithms of the provider, e.g. built by NewRSAGeneratedKeyProvider.
+// The provider is added with a priority above the replaced keys, so new tokens are signed by the new key.
+// After the grace period, e.g. the lifespan of the tokens signed by the old keys, the providers of the old keys
+// are disabled and removed. If ctx is done during the grace period, the new provider is kept and the old ones
+// are left untouched.
+func (g *GoKeycloak) RotateKeys(ctx context.Context, token, realm string, provider Component, gracePeriod time.Duration) (*KeyRotation, error) {
+	const errMessage = "could not rotate keys"
+
+	_, providerID, err := g.CreateComponent(ctx, token, realm, provider)
+	if err != nil {
+		return nil, err
+	}
+	rotation := &KeyRotation{ProviderID: providerID}
+
+	_, keys, err := g.GetKeyStoreConfig(ctx, token, realm)
+	if err != nil {
+		return rotation, err
+	}
+	algorithms := map[string]bool{}
+	for _, key := range keyList(keys) {
+		if PString(key.ProviderID) == providerID {
+			algorithms[PString(key.Algorithm)] = true
+		}
+	}
+	if len(algorithms) == 0 {
+		return rotation, errors.Errorf("%s: provider %s has no keys, is its config valid?", errMessage, providerID)
+	}
+	for algorithm := range algorithms {
+		rotation.Algorithms = append(rotation.Algorithms, algorithm)
+	}
+	sort.Strings(rotation.Algorithms)
+
+	maxPriority := int64(0)
+	replaced := map[string]bool{}
+	for _, key := range keyList(keys) {
+		if PString(key.ProviderID) == providerID || !algorithms[PString(key.Algorithm)] || PString(key.Status) != KeyStatusActive {
+			continue
+		}
+		if !replaced[PString(key.ProviderID)] {
+			replaced[PString(key.ProviderID)] = true
+			rotation.RemovedProviderIDs = append(rotation.RemovedProviderIDs, PString(key.ProviderID))
+		}
+		if key.ProviderPriority != nil && int64(*key.ProviderPriority) > maxPriority {
+			maxPriority = int64(*key.ProviderPriority)
+		}
+	}
+	if len(replaced) > 0 && KeyProviderPriority(&provider) <= maxPriority {
+		if _, err := g.SetKeyProviderPriority(ctx, token, realm, providerID, maxPriority+1); err != nil {
+			return rotation, err
+		}
+	}
+
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return rotation, errors.Wrap(ctx.Err(), errMessage)
+	case <-timer.C:
+	}
+
+	for _, oldID := range rotation.RemovedProviderIDs {
+		if _, err := g.SetKeyProviderState(ctx, token, realm, oldID, false, false); err != nil {
+			return rotation, err
+		}
+		if _, err := g.DeleteComponent(ctx, token, realm, oldID); err != nil {
+			return rotation, err
+		}
+	}
+
+	return rotation, nil
+}
+
+func keyList(config *KeyStoreConfig) []Key {
+	if config == nil || config.Key == nil {
+		return nil
+	}
+	return *config.Key
+}
+
+// AlgorithmKeys holds the kids of the keys of an algorithm by status, highest priority first
+type AlgorithmKeys struct {
+	Algorithm string
+	Active    []string
+	Passive   []string
+	Disabled  []string
+}
+
+// KeysByAlgorithm returns the kids of the keys grouped by algorithm and status, sorted by algorithm
+func (c *KeyStoreConfig) KeysByAlgorithm() []AlgorithmKeys {
+	keys := append([]Key{}, keyList(c)...)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return PInt(keys[i].ProviderPriority) > PInt(keys[j].ProviderPriority)
+	})
+
+	byAlgorithm := map[string]*AlgorithmKeys{}
+	for _, key := range keys {
+		algorithm := PString(key.Algorithm)
+		if byAlgorithm[algorithm] == nil {
+			byAlgorithm[algorithm] = &AlgorithmKeys{Algorithm: algorithm}
+		}
+		entry := byAlgorithm[algorithm]
+		switch PString(key.Status) {
+		case KeyStatusActive:
+			entry.Active = append(entry.Active, PString(key.Kid))
+		case KeyStatusPassive:
+			entry.Passive = append(entry.Passive, PString(key.Kid))
+		default:
+			entry.Disabled = append(entry.Disabled, PString(key.Kid))
+		}
+	}
+
+	result := make([]AlgorithmKeys, 0, len(byAlgorithm))
+	for _, entry := range byAlgorithm {
+		result = append(result, *entry)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Algorithm < result[j].Algorithm
+	})
+	return result
+}
+
+// GetKeysByAlgorithm returns the kids of the keys of the realm grouped by algorithm and status
+func (g *GoKeycloak) GetKeysByAlgorithm(ctx context.Context, token, realm string) ([]AlgorithmKeys, error) {
+	_, keys, err := g.GetKeyStoreConfig(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	return keys.KeysByAlgorithm(), nil
+}
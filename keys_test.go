@@ -0,0 +1,137 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeKeys keeps key providers in memory, every provider has one key of its configured algorithm
+type fakeKeys struct {
+	mu         sync.Mutex
+	components []*gokeycloak.Component
+	ids        int
+}
+
+func (f *fakeKeys) keys() gokeycloak.KeyStoreConfig {
+	keys := []gokeycloak.Key{}
+	for _, c := range f.components {
+		config := *c.ComponentConfig
+		status := gokeycloak.KeyStatusActive
+		if config["enabled"][0] != "true" {
+			status = gokeycloak.KeyStatusDisabled
+		} else if config["active"][0] != "true" {
+			status = gokeycloak.KeyStatusPassive
+		}
+		priority, _ := strconv.Atoi(config["priority"][0])
+		keys = append(keys, gokeycloak.Key{
+			ProviderID: c.ID, ProviderPriority: gokeycloak.IntP(priority), Kid: gokeycloak.StringP("kid-" + *c.ID),
+			Status: gokeycloak.StringP(status), Algorithm: gokeycloak.StringP(config["algorithm"][0]),
+		})
+	}
+	return gokeycloak.KeyStoreConfig{Key: &keys}
+}
+
+func (f *fakeKeys) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/admin/realms/test/")
+	switch {
+	case r.Method == http.MethodGet && path == "keys":
+		writeJSON(w, f.keys())
+	case r.Method == http.MethodGet && path == "components":
+		writeJSON(w, f.components)
+	case r.Method == http.MethodPost && path == "components":
+		var component gokeycloak.Component
+		_ = json.NewDecoder(r.Body).Decode(&component)
+		f.ids++
+		component.ID = gokeycloak.StringP(fmt.Sprintf("id-%d", f.ids))
+		f.components = append(f.components, &component)
+		w.Header().Set("Location", r.URL.String()+"/"+*component.ID)
+		w.WriteHeader(http.StatusCreated)
+	default:
+		for i, c := range f.components {
+			if path != "components/"+*c.ID {
+				continue
+			}
+			switch r.Method {
+			case http.MethodGet:
+				writeJSON(w, c)
+			case http.MethodPut:
+				_ = json.NewDecoder(r.Body).Decode(c)
+				w.WriteHeader(http.StatusNoContent)
+			case http.MethodDelete:
+				f.components = append(f.components[:i:i], f.components[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+			}
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_RotateKeys(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeKeys{}
+	server := newFakeServer(t, fake.ServeHTTP)
+
+	client := server.client()
+	ctx := context.Background()
+
+	for _, provider := range []gokeycloak.Component{
+		gokeycloak.NewRSAGeneratedKeyProvider("rsa", 100, "RS256", 2048),
+		gokeycloak.NewHMACGeneratedKeyProvider("hmac", 100, "HS256", 0),
+		gokeycloak.NewRSAGeneratedKeyProvider("rsa-passive", 50, "RS256", 0),
+	} {
+		_, _, err := client.CreateComponent(ctx, "token", "test", provider)
+		require.NoError(t, err)
+	}
+	status, err := client.SetKeyProviderState(ctx, "token", "test", "id-3", true, false)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, status)
+	require.Equal(t, []string{"2048"}, (*fake.components[0].ComponentConfig)["keySize"])
+	require.NotContains(t, *fake.components[1].ComponentConfig, "secretSize", "zero sizes are left to the defaults")
+
+	status, providers, err := client.GetKeyProviders(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, providers, 3)
+	require.Equal(t, int64(50), gokeycloak.KeyProviderPriority(providers[2]))
+
+	rotation, err := client.RotateKeys(ctx, "token", "test", gokeycloak.NewRSAGeneratedKeyProvider("rsa-new", 0, "RS256", 0), time.Millisecond)
+	require.NoError(t, err)
+	require.Equal(t, &gokeycloak.KeyRotation{ProviderID: "id-4", Algorithms: []string{"RS256"}, RemovedProviderIDs: []string{"id-1"}}, rotation)
+
+	keys, err := client.GetKeysByAlgorithm(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.AlgorithmKeys{
+		{Algorithm: "HS256", Active: []string{"kid-id-2"}},
+		{Algorithm: "RS256", Active: []string{"kid-id-4"}, Passive: []string{"kid-id-3"}},
+	}, keys)
+	_, providers, err = client.GetKeyProviders(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, "id-4", *providers[0].ID)
+	require.Equal(t, int64(101), gokeycloak.KeyProviderPriority(providers[0]), "the new key is used first")
+
+	// the old keys are kept if the grace period is cut short
+	short, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+	defer cancel()
+	rotation, err = client.RotateKeys(short, "token", "test", gokeycloak.NewHMACGeneratedKeyProvider("hmac-new", 200, "HS256", 64), time.Hour)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, []string{"id-2"}, rotation.RemovedProviderIDs)
+	keys, err = client.GetKeysByAlgorithm(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, []string{"kid-id-5", "kid-id-2"}, keys[0].Active)
+}
@@ -0,0 +1,427 @@
+package gokeycloak
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ------------------------------
+// LDAP and Kerberos User Federation
+// ------------------------------
+
+// Provider types of user federation components
+const (
+	// UserStorageProviderType is the providerType of user federation providers, e.g. LDAP or Kerberos
+	UserStorageProviderType = "org.keycloak.storage.UserStorageProvider"
+	// LDAPMapperProviderType is the providerType of the mappers of a LDAP provider
+	LDAPMapperProviderType = "org.keycloak.storage.ldap.mappers.LDAPStorageMapper"
+)
+
+// LDAPVendor is the vendor of a LDAP server
+type LDAPVendor string
+
+// LDAPVendor values
+const (
+	LDAPVendorActiveDirectory LDAPVendor = "ad"
+	LDAPVendorRedHatDirectory LDAPVendor = "rhds"
+	LDAPVendorTivoli          LDAPVendor = "tivoli"
+	LDAPVendorEDirectory      LDAPVendor = "edirectory"
+	LDAPVendorOther           LDAPVendor = "other"
+)
+
+// LDAPEditMode is the way users changed in Keycloak are written to LDAP
+type LDAPEditMode string
+
+// LDAPEditMode values
+const (
+	LDAPEditReadOnly LDAPEditMode = "READ_ONLY"
+	LDAPEditWritable LDAPEditMode = "WRITABLE"
+	LDAPEditUnsynced LDAPEditMode = "UNSYNCED"
+)
+
+// LDAPMapperMode is the way group and role mappers keep memberships
+type LDAPMapperMode string
+
+// LDAPMapperMode values
+const (
+	LDAPMapperReadOnly LDAPMapperMode = "READ_ONLY"
+	LDAPMapperLDAPOnly LDAPMapperMode = "LDAP_ONLY"
+	LDAPMapperImport   LDAPMapperMode = "IMPORT"
+)
+
+// LDAPSyncDirection is the direction of a mapper synchronization
+type LDAPSyncDirection string
+
+// LDAPSyncDirection values
+const (
+	LDAPSyncFedToKeycloak LDAPSyncDirection = "fedToKeycloak"
+	LDAPSyncKeycloakToFed LDAPSyncDirection = "keycloakToFed"
+)
+
+// Actions of TestLDAPConnection
+const (
+	LDAPTestConnection     = "testConnection"
+	LDAPTestAuthentication = "testAuthentication"
+)
+
+// componentConfig converts a config to the multivalued config of a component, empty values are left out
+func componentConfig(config map[string]string) *map[string][]string {
+	result := map[string][]string{}
+	for key, value := range config {
+		if value != "" {
+			result[key] = []string{value}
+		}
+	}
+	return &result
+}
+
+// ldapUserDefaults are the user attributes of the vendors as suggested by the admin console
+var ldapUserDefaults = map[LDAPVendor]map[string]string{
+	LDAPVendorActiveDirectory: {"usernameLDAPAttribute": "cn", "rdnLDAPAttribute": "cn", "uuidLDAPAttribute": "objectGUID", "userObjectClasses": "person, organizationalPerson, user"},
+	LDAPVendorRedHatDirectory: {"usernameLDAPAttribute": "uid", "rdnLDAPAttribute": "uid", "uuidLDAPAttribute": "nsuniqueid", "userObjectClasses": "inetOrgPerson, organizationalPerson"},
+	LDAPVendorTivoli:          {"usernameLDAPAttribute": "uid", "rdnLDAPAttribute": "uid", "uuidLDAPAttribute": "uniqueidentifier", "userObjectClasses": "inetOrgPerson, organizationalPerson"},
+	LDAPVendorEDirectory:      {"usernameLDAPAttribute": "uid", "rdnLDAPAttribute": "uid", "uuidLDAPAttribute": "guid", "userObjectClasses": "inetOrgPerson, organizationalPerson"},
+	LDAPVendorOther:           {"usernameLDAPAttribute": "uid", "rdnLDAPAttribute": "uid", "uuidLDAPAttribute": "entryUUID", "userObjectClasses": "inetOrgPerson, organizationalPerson"},
+}
+
+// LDAPProviderBuilder declares a LDAP user federation provider, e.g.
+//
+//	provider := NewLDAPProvider("corp", LDAPVendorActiveDirectory, "ldaps://ldap.example.com", "ou=users,dc=example,dc=com").
+//		Bind("cn=keycloak,dc=example,dc=com", secret).
+//		EditMode(LDAPEditReadOnly).
+//		SubtreeSearch().
+//		Component()
+//
+// The user attributes default to the ones the admin console suggests for the vendor.
+type LDAPProviderBuilder struct {
+	name   string
+	config map[string]string
+}
+
+// NewLDAPProvider starts the declaration of a LDAP provider importing the users below usersDN
+func NewLDAPProvider(name string, vendor LDAPVendor, connectionURL, usersDN string) *LDAPProviderBuilder {
+	config := map[string]string{
+		"enabled":       "true",
+		"vendor":        string(vendor),
+		"connectionUrl": connectionURL,
+		"usersDn":       usersDN,
+		"authType":      "none",
+		"editMode":      string(LDAPEditReadOnly),
+		"searchScope":   "1",
+		"importEnabled": "true",
+		"pagination":    "true",
+	}
+	for key, value := range ldapUserDefaults[vendor] {
+		config[key] = value
+	}
+
+	return &LDAPProviderBuilder{name: name, config: config}
+}
+
+// Bind authenticates the connection with a simple bind of the dn
+func (b *LDAPProviderBuilder) Bind(dn, credential string) *LDAPProviderBuilder {
+	b.config["authType"] = "simple"
+	b.config["bindDn"] = dn
+	b.config["bindCredential"] = credential
+	return b
+}
+
+// EditMode sets the way users changed in Keycloak are written to LDAP
+func (b *LDAPProviderBuilder) EditMode(mode LDAPEditMode) *LDAPProviderBuilder {
+	b.config["editMode"] = string(mode)
+	return b
+}
+
+// UserAttributes sets the LDAP attributes of the username, the rdn and the uuid of users, empty ones are kept
+func (b *LDAPProviderBuilder) UserAttributes(username, rdn, uuid string) *LDAPProviderBuilder {
+	for key, value := range map[string]string{"usernameLDAPAttribute": username, "rdnLDAPAttribute": rdn, "uuidLDAPAttribute": uuid} {
+		if value != "" {
+			b.config[key] = value
+		}
+	}
+	return b
+}
+
+// UserObjectClasses sets the object classes of the users
+func (b *LDAPProviderBuilder) UserObjectClasses(classes ...string) *LDAPProviderBuilder {
+	b.config["userObjectClasses"] = strings.Join(classes, ", ")
+	return b
+}
+
+// SearchFilter restricts the users by a LDAP filter, e.g. (memberOf=cn=staff,ou=groups,dc=example,dc=com)
+func (b *LDAPProviderBuilder) SearchFilter(filter string) *LDAPProviderBuilder {
+	b.config["customUserSearchFilter"] = filter
+	return b
+}
+
+// SubtreeSearch searches users in the whole subtree of the users dn instead of one level only
+func (b *LDAPProviderBuilder) SubtreeSearch() *LDAPProviderBuilder {
+	b.config["searchScope"] = "2"
+	return b
+}
+
+// StartTLS encrypts the connection by StartTLS
+func (b *LDAPProviderBuilder) StartTLS() *LDAPProviderBuilder {
+	b.config["startTls"] = "true"
+	return b
+}
+
+// Import sets whether users are imported into Keycloak, without import users are read from LDAP only
+func (b *LDAPProviderBuilder) Import(enabled bool) *LDAPProviderBuilder {
+	b.config["importEnabled"] = strconv.FormatBool(enabled)
+	return b
+}
+
+// SyncRegistrations writes users registered in Keycloak to LDAP, it needs LDAPEditWritable
+func (b *LDAPProviderBuilder) SyncRegistrations() *LDAPProviderBuilder {
+	b.config["syncRegistrations"] = "true"
+	return b
+}
+
+// PeriodicSync synchronizes all users every full and the changed users every changed period, zero disables a sync
+func (b *LDAPProviderBuilder) PeriodicSync(full, changed time.Duration) *LDAPProviderBuilder {
+	period := func(d time.Duration) string {
+		if d <= 0 {
+			return "-1"
+		}
+		return strconv.FormatInt(int64(d/time.Second), 10)
+	}
+	b.config["fullSyncPeriod"] = period(full)
+	b.config["changedSyncPeriod"] = period(changed)
+	return b
+}
+
+// Kerberos authenticates users by SPNEGO with the keytab of the server principal,
+// passwordAuthentication checks passwords against Kerberos instead of LDAP
+func (b *LDAPProviderBuilder) Kerberos(kerberosRealm, serverPrincipal, keyTab string, passwordAuthentication bool) *LDAPProviderBuilder {
+	b.config["allowKerberosAuthentication"] = "true"
+	b.config["kerberosRealm"] = kerberosRealm
+	b.config["serverPrincipal"] = serverPrincipal
+	b.config["keyTab"] = keyTab
+	b.config["useKerberosForPasswordAuthentication"] = strconv.FormatBool(passwordAuthentication)
+	return b
+}
+
+// Priority sets the order in which the user federation providers are searched, lowest first
+func (b *LDAPProviderBuilder) Priority(priority int) *LDAPProviderBuilder {
+	b.config["priority"] = strconv.Itoa(priority)
+	return b
+}
+
+// Config sets any other config of the provider, e.g. connectionTimeout
+func (b *LDAPProviderBuilder) Config(key, value string) *LDAPProviderBuilder {
+	b.config[key] = value
+	return b
+}
+
+// Component returns the provider to be created by CreateComponent
+func (b *LDAPProviderBuilder) Component() Component {
+	return Component{
+		Name:            StringP(b.name),
+		ProviderID:      StringP("ldap"),
+		ProviderType:    StringP(UserStorageProviderType),
+		ComponentConfig: componentConfig(b.config),
+	}
+}
+
+// ConnectionTest returns the settings of a TestLDAPConnection of the provider, action is LDAPTestConnection
+// or LDAPTestAuthentication. The componentID of a stored provider lets Keycloak use its stored bind credential.
+func (b *LDAPProviderBuilder) ConnectionTest(action, componentID string) TestLDAPConnectionRepresentation {
+	test := TestLDAPConnectionRepresentation{
+		Action:           StringP(action),
+		ConnectionURL:    StringP(b.config["connectionUrl"]),
+		AuthType:         StringP(b.config["authType"]),
+		BindDN:           StringP(b.config["bindDn"]),
+		BindCredential:   StringP(b.config["bindCredential"]),
+		UseTruststoreSpi: StringP("always"),
+		StartTLS:         StringP(strconv.FormatBool(b.config["startTls"] == "true")),
+	}
+	if componentID != "" {
+		test.ComponentID = StringP(componentID)
+	}
+	return test
+}
+
+// NewKerberosProvider returns a Kerberos user federation provider authenticating users by SPNEGO
+func NewKerberosProvider(name, kerberosRealm, serverPrincipal, keyTab string) Component {
+	return Component{
+		Name:         StringP(name),
+		ProviderID:   StringP("kerberos"),
+		ProviderType: StringP(UserStorageProviderType),
+		ComponentConfig: componentConfig(map[string]string{
+			"enabled":                     "true",
+			"kerberosRealm":               kerberosRealm,
+			"serverPrincipal":             serverPrincipal,
+			"keyTab":                      keyTab,
+			"allowPasswordAuthentication": "true",
+		}),
+	}
+}
+
+func ldapMapper(ldapID, name, providerID string, config map[string]string) Component {
+	return Component{
+		Name:            StringP(name),
+		ProviderID:      StringP(providerID),
+		ProviderType:    StringP(LDAPMapperProviderType),
+		ParentID:        StringP(ldapID),
+		ComponentConfig: componentConfig(config),
+	}
+}
+
+// NewLDAPUserAttributeMapper returns a mapper of the LDAP provider from a LDAP attribute to a user attribute,
+// e.g. mail to email
+func NewLDAPUserAttributeMapper(ldapID, name, userModelAttribute, ldapAttribute string, readOnly bool) Component {
+	return ldapMapper(ldapID, name, "user-attribute-ldap-mapper", map[string]string{
+		"user.model.attribute":        userModelAttribute,
+		"ldap.attribute":              ldapAttribute,
+		"read.only":                   strconv.FormatBool(readOnly),
+		"always.read.value.from.ldap": "true",
+		"is.mandatory.in.ldap":        "false",
+	})
+}
+
+// NewLDAPFullNameMapper returns a mapper of the LDAP provider from a full name attribute, e.g. cn,
+// to the first and last name of users
+func NewLDAPFullNameMapper(ldapID, name, ldapAttribute string, readOnly bool) Component {
+	return ldapMapper(ldapID, name, "full-name-ldap-mapper", map[string]string{
+		"ldap.full.name.attribute": ldapAttribute,
+		"read.only":                strconv.FormatBool(readOnly),
+		"write.only":               "false",
+	})
+}
+
+// NewLDAPGroupMapper returns a mapper of the LDAP provider from the groupOfNames below groupsDN to groups
+func NewLDAPGroupMapper(ldapID, name, groupsDN string, mode LDAPMapperMode) Component {
+	return ldapMapper(ldapID, name, "group-ldap-mapper", map[string]string{
+		"groups.dn":                            groupsDN,
+		"group.name.ldap.attribute":            "cn",
+		"group.object.classes":                 "groupOfNames",
+		"membership.ldap.attribute":            "member",
+		"membership.attribute.type":            "DN",
+		"membership.user.ldap.attribute":       "uid",
+		"mode":                                 string(mode),
+		"user.roles.retrieve.strategy":         "LOAD_GROUPS_BY_MEMBER_ATTRIBUTE",
+		"preserve.group.inheritance":           "true",
+		"ignore.missing.groups":                "false",
+		"drop.non.existing.groups.during.sync": "false",
+	})
+}
+
+// NewLDAPRoleMapper returns a mapper of the LDAP provider from the groupOfNames below rolesDN to roles
+// of the client with clientID, or to realm roles if clientID is empty
+func NewLDAPRoleMapper(ldapID, name, rolesDN, clientID string, mode LDAPMapperMode) Component {
+	return ldapMapper(ldapID, name, "role-ldap-mapper", map[string]string{
+		"roles.dn":                       rolesDN,
+		"role.name.ldap.attribute":       "cn",
+		"role.object.classes":            "groupOfNames",
+		"membership.ldap.attribute":      "member",
+		"membership.attribute.type":      "DN",
+		"membership.user.ldap.attribute": "uid",
+		"mode":                           string(mode),
+		"user.roles.retrieve.strategy":   "LOAD_ROLES_BY_MEMBER_ATTRIBUTE",
+		"use.realm.roles.mapping":        strconv.FormatBool(clientID == ""),
+		"client.id":                      clientID,
+	})
+}
+
+// NewLDAPHardcodedRoleMapper returns a mapper of the LDAP provider granting the role to all its users,
+// client roles are given as clientId.role
+func NewLDAPHardcodedRoleMapper(ldapID, name, role string) Component {
+	return ldapMapper(ldapID, name, "hardcoded-ldap-role-mapper", map[string]string{"role": role})
+}
+
+// GetLDAPMappers returns the mappers of a LDAP provider
+func (g *GoKeycloak) GetLDAPMappers(ctx context.Context, token, realm, ldapID string) (int, []*Component, error) {
+	const errMessage = "could not get ldap mappers"
+
+	var result []*Component
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParams(map[string]string{
+			"parent": ldapID,
+			"type":   LDAPMapperProviderType,
+		}).
+		Get(g.getAdminRealmURL(realm, "components"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// TriggerUserStorageFullSync synchronizes all users of a user federation provider
+func (g *GoKeycloak) TriggerUserStorageFullSync(ctx context.Context, token, realm, storageID string) (int, *SynchronizationResult, error) {
+	return g.syncUserStorage(ctx, token, realm, storageID, "triggerFullSync")
+}
+
+// TriggerUserStorageChangedUsersSync synchronizes the users of a user federation provider changed since the last sync
+func (g *GoKeycloak) TriggerUserStorageChangedUsersSync(ctx context.Context, token, realm, storageID string) (int, *SynchronizationResult, error) {
+	return g.syncUserStorage(ctx, token, realm, storageID, "triggerChangedUsersSync")
+}
+
+func (g *GoKeycloak) syncUserStorage(ctx context.Context, token, realm, storageID, action string) (int, *SynchronizationResult, error) {
+	const errMessage = "could not sync user storage"
+
+	var result SynchronizationResult
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("action", action).
+		Post(g.getAdminRealmURL(realm, "user-storage", storageID, "sync"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// SyncLDAPMapper synchronizes the groups or roles of a LDAP mapper in the direction
+func (g *GoKeycloak) SyncLDAPMapper(ctx context.Context, token, realm, ldapID, mapperID string, direction LDAPSyncDirection) (int, *SynchronizationResult, error) {
+	const errMessage = "could not sync ldap mapper"
+
+	var result SynchronizationResult
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParam("direction", string(direction)).
+		Post(g.getAdminRealmURL(realm, "user-storage", ldapID, "mappers", mapperID, "sync"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// RemoveImportedUsers deletes the users imported by a user federation provider from Keycloak
+func (g *GoKeycloak) RemoveImportedUsers(ctx context.Context, token, realm, storageID string) (int, error) {
+	const errMessage = "could not remove imported users"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Post(g.getAdminRealmURL(realm, "user-storage", storageID, "remove-imported-users"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// UnlinkUsers turns the users imported by a user federation provider into local users
+func (g *GoKeycloak) UnlinkUsers(ctx context.Context, token, realm, storageID string) (int, error) {
+	const errMessage = "could not unlink users"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Post(g.getAdminRealmURL(realm, "user-storage", storageID, "unlink-users"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// TestLDAPConnection tests the connection or the bind to a LDAP server, e.g. built by LDAPProviderBuilder.ConnectionTest
+func (g *GoKeycloak) TestLDAPConnection(ctx context.Context, token, realm string, test TestLDAPConnectionRepresentation) (int, error) {
+	const errMessage = "ldap connection test failed"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(test).
+		Post(g.getAdminRealmURL(realm, "testLDAPConnection"))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
@@ -0,0 +1,141 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func TestLDAPProviderBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder := gokeycloak.NewLDAPProvider("corp", gokeycloak.LDAPVendorActiveDirectory, "ldaps://ldap.example.com", "ou=users,dc=example,dc=com").
+		Bind("cn=keycloak,dc=example,dc=com", "secret").
+		EditMode(gokeycloak.LDAPEditWritable).
+		UserAttributes("sAMAccountName", "", "").
+		SubtreeSearch().
+		PeriodicSync(24*time.Hour, 0).
+		Kerberos("EXAMPLE.COM", "HTTP/sso.example.com@EXAMPLE.COM", "/etc/krb5.keytab", false)
+	provider := builder.Component()
+
+	require.Equal(t, "ldap", *provider.ProviderID)
+	require.Equal(t, gokeycloak.UserStorageProviderType, *provider.ProviderType)
+	config := *provider.ComponentConfig
+	require.Equal(t, []string{"ad"}, config["vendor"])
+	require.Equal(t, []string{"simple"}, config["authType"])
+	require.Equal(t, []string{"WRITABLE"}, config["editMode"])
+	require.Equal(t, []string{"sAMAccountName"}, config["usernameLDAPAttribute"])
+	require.Equal(t, []string{"cn"}, config["rdnLDAPAttribute"], "vendor defaults are kept")
+	require.Equal(t, []string{"objectGUID"}, config["uuidLDAPAttribute"])
+	require.Equal(t, []string{"2"}, config["searchScope"])
+	require.Equal(t, []string{"86400"}, config["fullSyncPeriod"])
+	require.Equal(t, []string{"-1"}, config["changedSyncPeriod"])
+	require.Equal(t, []string{"true"}, config["allowKerberosAuthentication"])
+
+	test := builder.ConnectionTest(gokeycloak.LDAPTestAuthentication, "")
+	require.Equal(t, "cn=keycloak,dc=example,dc=com", *test.BindDN)
+	require.Nil(t, test.ComponentID)
+
+	mapper := gokeycloak.NewLDAPRoleMapper("ldap-id", "roles", "ou=roles,dc=example,dc=com", "", gokeycloak.LDAPMapperReadOnly)
+	require.Equal(t, "ldap-id", *mapper.ParentID)
+	require.Equal(t, gokeycloak.LDAPMapperProviderType, *mapper.ProviderType)
+	require.Equal(t, []string{"true"}, (*mapper.ComponentConfig)["use.realm.roles.mapping"])
+	require.NotContains(t, *mapper.ComponentConfig, "client.id")
+}
+
+// fakeUserStorage serves the synchronization of the LDAP provider ldap-id, its connection test succeeds for
+// ldap://ok only
+func fakeUserStorage(t *testing.T) *fakeServer {
+	t.Helper()
+
+	return newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/admin/realms/test/user-storage/ldap-id/sync":
+			writeJSON(w, map[string]interface{}{"ignored": false, "added": 3, "updated": 1, "removed": 0, "failed": 2,
+				"status": "3 imported users, 1 updated users, 2 users failed sync!"})
+		case "/admin/realms/test/user-storage/ldap-id/mappers/mapper-id/sync":
+			writeJSON(w, map[string]interface{}{"ignored": false, "added": 5})
+		case "/admin/realms/test/user-storage/ldap-id/remove-imported-users", "/admin/realms/test/user-storage/ldap-id/unlink-users":
+			w.WriteHeader(http.StatusNoContent)
+		case "/admin/realms/test/components":
+			writeJSON(w, []gokeycloak.Component{gokeycloak.NewLDAPHardcodedRoleMapper("ldap-id", "admins", "admin")})
+		case "/admin/realms/test/testLDAPConnection":
+			var test gokeycloak.TestLDAPConnectionRepresentation
+			_ = json.NewDecoder(r.Body).Decode(&test)
+			if *test.ConnectionURL != "ldap://ok" {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errorMessage": "LDAPConnectionTestFailed"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func Test_UserStorageSync(t *testing.T) {
+	t.Parallel()
+
+	server := fakeUserStorage(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, result, err := client.TriggerUserStorageFullSync(ctx, "token", "test", "ldap-id")
+	require.NoError(t, err)
+	require.Equal(t, 3, *result.Added)
+	require.Equal(t, 2, *result.Failed)
+	_, _, err = client.TriggerUserStorageChangedUsersSync(ctx, "token", "test", "ldap-id")
+	require.NoError(t, err)
+	_, result, err = client.SyncLDAPMapper(ctx, "token", "test", "ldap-id", "mapper-id", gokeycloak.LDAPSyncFedToKeycloak)
+	require.NoError(t, err)
+	require.Equal(t, 5, *result.Added)
+	_, err = client.RemoveImportedUsers(ctx, "token", "test", "ldap-id")
+	require.NoError(t, err)
+	_, err = client.UnlinkUsers(ctx, "token", "test", "ldap-id")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"POST /admin/realms/test/user-storage/ldap-id/sync?action=triggerFullSync",
+		"POST /admin/realms/test/user-storage/ldap-id/sync?action=triggerChangedUsersSync",
+		"POST /admin/realms/test/user-storage/ldap-id/mappers/mapper-id/sync?direction=fedToKeycloak",
+		"POST /admin/realms/test/user-storage/ldap-id/remove-imported-users",
+		"POST /admin/realms/test/user-storage/ldap-id/unlink-users",
+	}, server.calls())
+}
+
+func Test_TestLDAPConnection(t *testing.T) {
+	t.Parallel()
+
+	client := fakeUserStorage(t).client()
+	ctx := context.Background()
+
+	builder := gokeycloak.NewLDAPProvider("corp", gokeycloak.LDAPVendorOther, "ldap://ok", "ou=users,dc=example,dc=com")
+	_, err := client.TestLDAPConnection(ctx, "token", "test", builder.ConnectionTest(gokeycloak.LDAPTestConnection, "ldap-id"))
+	require.NoError(t, err)
+	builder.Config("connectionUrl", "ldap://down")
+	status, err := client.TestLDAPConnection(ctx, "token", "test", builder.ConnectionTest(gokeycloak.LDAPTestConnection, ""))
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, status)
+}
+
+func Test_GetLDAPMappers(t *testing.T) {
+	t.Parallel()
+
+	server := fakeUserStorage(t)
+	status, mappers, err := server.client().GetLDAPMappers(context.Background(), "token", "test", "ldap-id")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, status)
+	require.Len(t, mappers, 1)
+	require.Equal(t, "admins", gokeycloak.PString(mappers[0].Name))
+	require.Equal(t, []string{
+		"GET /admin/realms/test/components?parent=ldap-id&type=org.keycloak.storage.ldap.mappers.LDAPStorageMapper",
+	}, server.calls())
+}
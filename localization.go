@@ -0,0 +1,360 @@
+package gokeycloak
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------
+// Realm Localization
+// ------------------------------
+
+// GetRealmLocales returns the locales of the realm having localization texts
+func (g *GoKeycloak) GetRealmLocales(ctx context.Context, token, realm string) (int, []string, error) {
+	const errMessage = "could not get realm locales"
+
+	var result []string
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "localization"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// GetRealmLocalizationTexts returns the localization texts of the realm for the locale by key
+func (g *GoKeycloak) GetRealmLocalizationTexts(ctx context.Context, token, realm, locale string) (int, map[string]string, error) {
+	const errMessage = "could not get realm localization texts"
+
+	result := map[string]string{}
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "localization", locale))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// ImportRealmLocalizationTexts adds or replaces localization texts of the realm for the locale, other texts are kept
+func (g *GoKeycloak) ImportRealmLocalizationTexts(ctx context.Context, token, realm, locale string, texts map[string]string) (int, error) {
+	const errMessage = "could not import realm localization texts"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(texts).
+		Post(g.getAdminRealmURL(realm, "localization", locale))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// DeleteRealmLocalizationTexts deletes all localization texts of the realm for the locale
+func (g *GoKeycloak) DeleteRealmLocalizationTexts(ctx context.Context, token, realm, locale string) (int, error) {
+	const errMessage = "could not delete realm localization texts"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "localization", locale))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// GetRealmLocalizationText returns a localization text of the realm
+func (g *GoKeycloak) GetRealmLocalizationText(ctx context.Context, token, realm, locale, key string) (int, string, error) {
+	const errMessage = "could not get realm localization text"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Get(g.getAdminRealmURL(realm, "localization", locale, key))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), "", err
+	}
+
+	return resp.StatusCode(), resp.String(), nil
+}
+
+// UpdateRealmLocalizationText adds or replaces a localization text of the realm
+func (g *GoKeycloak) UpdateRealmLocalizationText(ctx context.Context, token, realm, locale, key, text string) (int, error) {
+	const errMessage = "could not update realm localization text"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetHeader("Content-Type", "text/plain; charset=utf-8").
+		SetBody(text).
+		Put(g.getAdminRealmURL(realm, "localization", locale, key))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// DeleteRealmLocalizationText deletes a localization text of the realm
+func (g *GoKeycloak) DeleteRealmLocalizationText(ctx context.Context, token, realm, locale, key string) (int, error) {
+	const errMessage = "could not delete realm localization text"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		Delete(g.getAdminRealmURL(realm, "localization", locale, key))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
+// ImportRealmMessages imports the texts of a messages properties file, e.g. messages_de.properties of a theme,
+// as localization texts of the realm for the locale
+func (g *GoKeycloak) ImportRealmMessages(ctx context.Context, token, realm, locale string, messages io.Reader) (int, error) {
+	texts, err := ParseMessageProperties(messages)
+	if err != nil {
+		return 0, err
+	}
+	return g.ImportRealmLocalizationTexts(ctx, token, realm, locale, texts)
+}
+
+// ParseMessageProperties parses a messages file in the java properties format of Keycloak themes.
+// The file is read as UTF-8, \uXXXX escapes are supported as well.
+func ParseMessageProperties(r io.Reader) (map[string]string, error) {
+	const errMessage = "could not parse message properties"
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+
+	result := map[string]string{}
+	lines := strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		// a line ending in an odd number of backslashes continues on the next line
+		for endsWithEscape(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+
+		end := len(line)
+		for j := 0; j < len(line); j++ {
+			if line[j] == '\\' {
+				j++
+				continue
+			}
+			if strings.IndexByte("=: \t\f", line[j]) >= 0 {
+				end = j
+				break
+			}
+		}
+		value := strings.TrimLeft(line[end:], " \t\f")
+		if value != "" && (value[0] == '=' || value[0] == ':') {
+			value = strings.TrimLeft(value[1:], " \t\f")
+		}
+
+		key, err := unescapeProperty(line[:end])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s: line %d", errMessage, i+1)
+		}
+		if result[key], err = unescapeProperty(value); err != nil {
+			return nil, errors.Wrapf(err, "%s: line %d", errMessage, i+1)
+		}
+	}
+
+	return result, nil
+}
+
+func endsWithEscape(line string) bool {
+	backslashes := len(line) - len(strings.TrimRight(line, "\\"))
+	return backslashes%2 == 1
+}
+
+func unescapeProperty(value string) (string, error) {
+	if !strings.Contains(value, "\\") {
+		return value, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+		i++
+		switch value[i] {
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 'f':
+			b.WriteByte('\f')
+		case 'u':
+			if i+5 > len(value) {
+				return "", errors.Errorf("malformed \\u escape in %q", value)
+			}
+			r, err := strconv.ParseUint(value[i+1:i+5], 16, 32)
+			if err != nil {
+				return "", errors.Errorf("malformed \\u escape in %q", value)
+			}
+			b.WriteRune(rune(r))
+			i += 4
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+	return b.String(), nil
+}
+
+// LocalizationChange is a difference between the local messages and the localization texts of a realm.
+// A change with an empty Key is a locale missing from the supported locales of the realm.
+type LocalizationChange struct {
+	Locale string
+	Key    string
+	Kind   DifferenceKind
+	Local  string
+	Realm  string
+}
+
+// readMessagesDir reads the messages_<locale>.properties files of dir by locale,
+// underscores of the locale are replaced by dashes, e.g. messages_pt_BR.properties is pt-BR
+func readMessagesDir(dir string) (map[string]map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "messages_*.properties"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read messages")
+	}
+
+	result := map[string]map[string]string{}
+	for _, file := range files {
+		locale := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(file), "messages_"), ".properties")
+		locale = strings.ReplaceAll(locale, "_", "-")
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read messages")
+		}
+		texts, err := ParseMessageProperties(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, errors.Wrap(err, file)
+		}
+		result[locale] = texts
+	}
+	return result, nil
+}
+
+// DiffRealmLocalization compares the messages_<locale>.properties files of dir with the localization texts
+// and supported locales of the realm. Texts of the realm missing locally are only reported with prune.
+// Locales without a file are not compared.
+func (g *GoKeycloak) DiffRealmLocalization(ctx context.Context, token, realm, dir string, prune bool) ([]LocalizationChange, error) {
+	local, err := readMessagesDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	_, realmRepresentation, err := g.GetRealm(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := make([]string, 0, len(local))
+	for locale := range local {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	var changes []LocalizationChange
+	for _, locale := range locales {
+		if !PBool(realmRepresentation.InternationalizationEnabled) || !containsString(PStringSlice(realmRepresentation.SupportedLocales), locale) {
+			changes = append(changes, LocalizationChange{Locale: locale, Kind: DifferenceAdded})
+		}
+
+		_, texts, err := g.GetRealmLocalizationTexts(ctx, token, realm, locale)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(local[locale])+len(texts))
+		for key := range local[locale] {
+			keys = append(keys, key)
+		}
+		for key := range texts {
+			if _, ok := local[locale][key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			want, isLocal := local[locale][key]
+			have, isRealm := texts[key]
+			switch {
+			case !isRealm:
+				changes = append(changes, LocalizationChange{Locale: locale, Key: key, Kind: DifferenceAdded, Local: want})
+			case !isLocal && prune:
+				changes = append(changes, LocalizationChange{Locale: locale, Key: key, Kind: DifferenceRemoved, Realm: have})
+			case isLocal && want != have:
+				changes = append(changes, LocalizationChange{Locale: locale, Key: key, Kind: DifferenceChanged, Local: want, Realm: have})
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// ApplyRealmLocalization brings the realm in line with the messages_<locale>.properties files of dir,
+// see DiffRealmLocalization, and returns the applied changes. Missing locales are added to the supported locales
+// of the realm and internationalization is enabled. With prune, texts of the realm missing locally are deleted.
+func (g *GoKeycloak) ApplyRealmLocalization(ctx context.Context, token, realm, dir string, prune bool) ([]LocalizationChange, error) {
+	changes, err := g.DiffRealmLocalization(ctx, token, realm, dir, prune)
+	if err != nil {
+		return nil, err
+	}
+
+	var missingLocales []string
+	imports := map[string]map[string]string{}
+	for _, change := range changes {
+		switch {
+		case change.Key == "":
+			missingLocales = append(missingLocales, change.Locale)
+		case change.Kind == DifferenceRemoved:
+			if _, err := g.DeleteRealmLocalizationText(ctx, token, realm, change.Locale, change.Key); err != nil {
+				return nil, err
+			}
+		default:
+			if imports[change.Locale] == nil {
+				imports[change.Locale] = map[string]string{}
+			}
+			imports[change.Locale][change.Key] = change.Local
+		}
+	}
+
+	if len(missingLocales) > 0 {
+		_, realmRepresentation, err := g.GetRealm(ctx, token, realm)
+		if err != nil {
+			return nil, err
+		}
+		locales := PStringSlice(realmRepresentation.SupportedLocales)
+		for _, locale := range missingLocales {
+			if !containsString(locales, locale) {
+				locales = append(locales, locale)
+			}
+		}
+		_, err = g.UpdateRealm(ctx, token, RealmRepresentation{
+			Realm:                       StringP(realm),
+			InternationalizationEnabled: BoolP(true),
+			SupportedLocales:            &locales,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for locale, texts := range imports {
+		if _, err := g.ImportRealmLocalizationTexts(ctx, token, realm, locale, texts); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
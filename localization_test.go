@@ -0,0 +1,183 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func TestParseMessageProperties(t *testing.T) {
+	t.Parallel()
+
+	texts, err := gokeycloak.ParseMessageProperties(strings.NewReader(`# login page
+! also a comment
+loginTitle=Anmelden bei {0}
+  doLogIn : Anmelden
+invalidUserMessage Ung\u00fcltiger Benutzername oder Passwort.
+termsText=<p>Bitte \
+    lesen Sie die Bedingungen</p>
+key\=with\:separators=value=with=equals
+emptyValue=
+path=C:\\temp
+`))
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"loginTitle":          "Anmelden bei {0}",
+		"doLogIn":             "Anmelden",
+		"invalidUserMessage":  "Ungültiger Benutzername oder Passwort.",
+		"termsText":           "<p>Bitte lesen Sie die Bedingungen</p>",
+		"key=with:separators": "value=with=equals",
+		"emptyValue":          "",
+		"path":                `C:\temp`,
+	}, texts)
+
+	_, err = gokeycloak.ParseMessageProperties(strings.NewReader(`broken=\u00z`))
+	require.Error(t, err)
+}
+
+// fakeLocalization is the realm test with its localization texts in a fakeServer
+type fakeLocalization struct {
+	mu    sync.Mutex
+	realm gokeycloak.RealmRepresentation
+	texts map[string]map[string]string
+}
+
+func newFakeLocalization(t *testing.T) (*fakeServer, *fakeLocalization) {
+	t.Helper()
+
+	localization := &fakeLocalization{
+		realm: gokeycloak.RealmRepresentation{Realm: gokeycloak.StringP("test"), SupportedLocales: &[]string{"en"}},
+		texts: map[string]map[string]string{
+			"de": {"loginTitle": "Login", "custom": "set in the admin console"},
+		},
+	}
+	return newFakeServer(t, localization.ServeHTTP), localization
+}
+
+func (f *fakeLocalization) locale(locale string) (gokeycloak.RealmRepresentation, map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	texts := map[string]string{}
+	for key, text := range f.texts[locale] {
+		texts[key] = text
+	}
+	return f.realm, texts
+}
+
+func (f *fakeLocalization) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, "/admin/realms/test"), "/")
+	switch {
+	case len(path) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, f.realm)
+	case len(path) == 1 && r.Method == http.MethodPut:
+		_ = json.NewDecoder(r.Body).Decode(&f.realm)
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 2 && r.Method == http.MethodGet:
+		locales := []string{}
+		for locale := range f.texts {
+			locales = append(locales, locale)
+		}
+		writeJSON(w, locales)
+	case len(path) == 3 && r.Method == http.MethodGet:
+		writeJSON(w, f.texts[path[2]])
+	case len(path) == 3 && r.Method == http.MethodPost:
+		var imported map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&imported)
+		if f.texts[path[2]] == nil {
+			f.texts[path[2]] = map[string]string{}
+		}
+		for key, text := range imported {
+			f.texts[path[2]][key] = text
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 4 && r.Method == http.MethodGet:
+		if text, ok := f.texts[path[2]][path[3]]; ok {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(text))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case len(path) == 4 && r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.texts[path[2]][path[3]] = string(body)
+		w.WriteHeader(http.StatusNoContent)
+	case len(path) == 4 && r.Method == http.MethodDelete:
+		delete(f.texts[path[2]], path[3])
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func Test_ApplyRealmLocalization(t *testing.T) {
+	t.Parallel()
+
+	server, localization := newFakeLocalization(t)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "messages_de.properties"), []byte("loginTitle=Anmelden\ndoLogIn=Anmelden\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "messages_pt_BR.properties"), []byte("doLogIn=Entrar\n"), 0o600))
+
+	client := server.client()
+	ctx := context.Background()
+
+	changes, err := client.ApplyRealmLocalization(ctx, "token", "test", dir, false)
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.LocalizationChange{
+		{Locale: "de", Kind: gokeycloak.DifferenceAdded},
+		{Locale: "de", Key: "doLogIn", Kind: gokeycloak.DifferenceAdded, Local: "Anmelden"},
+		{Locale: "de", Key: "loginTitle", Kind: gokeycloak.DifferenceChanged, Local: "Anmelden", Realm: "Login"},
+		{Locale: "pt-BR", Kind: gokeycloak.DifferenceAdded},
+		{Locale: "pt-BR", Key: "doLogIn", Kind: gokeycloak.DifferenceAdded, Local: "Entrar"},
+	}, changes)
+	realm, _ := localization.locale("de")
+	require.True(t, *realm.InternationalizationEnabled)
+	require.Equal(t, []string{"en", "de", "pt-BR"}, *realm.SupportedLocales)
+
+	changes, err = client.DiffRealmLocalization(ctx, "token", "test", dir, false)
+	require.NoError(t, err)
+	require.Empty(t, changes, "applying must be idempotent")
+
+	changes, err = client.ApplyRealmLocalization(ctx, "token", "test", dir, true)
+	require.NoError(t, err)
+	require.Equal(t, []gokeycloak.LocalizationChange{
+		{Locale: "de", Key: "custom", Kind: gokeycloak.DifferenceRemoved, Realm: "set in the admin console"},
+	}, changes)
+	_, texts := localization.locale("de")
+	require.NotContains(t, texts, "custom")
+}
+
+func Test_RealmLocalizationTexts(t *testing.T) {
+	t.Parallel()
+
+	server, _ := newFakeLocalization(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, err := client.UpdateRealmLocalizationText(ctx, "token", "test", "de", "doLogIn", "Einloggen")
+	require.NoError(t, err)
+	request := server.lastRequest(t, http.MethodPut, "/admin/realms/test/localization/de/doLogIn")
+	require.Equal(t, "text/plain; charset=utf-8", request.Header.Get("Content-Type"))
+	_, text, err := client.GetRealmLocalizationText(ctx, "token", "test", "de", "doLogIn")
+	require.NoError(t, err)
+	require.Equal(t, "Einloggen", text)
+
+	_, err = client.ImportRealmMessages(ctx, "token", "test", "fr", strings.NewReader("doLogIn=Connexion"))
+	require.NoError(t, err)
+	_, locales, err := client.GetRealmLocales(ctx, "token", "test")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"de", "fr"}, locales)
+}
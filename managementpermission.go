@@ -0,0 +1,168 @@
+package gokeycloak
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// ------------------------------------
+// Fine Grained Admin Permissions
+// ------------------------------------
+
+// realmManagementClientID is the clientId of the client holding the fine grained admin permissions
+const realmManagementClientID = "realm-management"
+
+// GetUsersManagementPermissions returns the fine grained admin permissions of the users of the realm
+func (g *GoKeycloak) GetUsersManagementPermissions(ctx context.Context, token, realm string) (int, *ManagementPermissionReference, error) {
+	return g.getManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "users-management-permissions"))
+}
+
+// UpdateUsersManagementPermissions enables or disables the fine grained admin permissions of the users of the realm
+func (g *GoKeycloak) UpdateUsersManagementPermissions(ctx context.Context, token, realm string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	return g.updateManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "users-management-permissions"), permissions)
+}
+
+// GetGroupManagementPermissions returns the fine grained admin permissions of a group
+func (g *GoKeycloak) GetGroupManagementPermissions(ctx context.Context, token, realm, groupID string) (int, *ManagementPermissionReference, error) {
+	return g.getManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "groups", groupID, "management", "permissions"))
+}
+
+// UpdateGroupManagementPermissions enables or disables the fine grained admin permissions of a group
+func (g *GoKeycloak) UpdateGroupManagementPermissions(ctx context.Context, token, realm, groupID string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	return g.updateManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "groups", groupID, "management", "permissions"), permissions)
+}
+
+// GetClientManagementPermissions returns the fine grained admin permissions of a client
+func (g *GoKeycloak) GetClientManagementPermissions(ctx context.Context, token, realm, idOfClient string) (int, *ManagementPermissionReference, error) {
+	return g.getManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "clients", idOfClient, "management", "permissions"))
+}
+
+// UpdateClientManagementPermissions enables or disables the fine grained admin permissions of a client
+func (g *GoKeycloak) UpdateClientManagementPermissions(ctx context.Context, token, realm, idOfClient string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	return g.updateManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "clients", idOfClient, "management", "permissions"), permissions)
+}
+
+// GetRoleManagementPermissions returns the fine grained admin permissions of a role
+func (g *GoKeycloak) GetRoleManagementPermissions(ctx context.Context, token, realm, roleID string) (int, *ManagementPermissionReference, error) {
+	return g.getManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "roles-by-id", roleID, "management", "permissions"))
+}
+
+// UpdateRoleManagementPermissions enables or disables the fine grained admin permissions of a role
+func (g *GoKeycloak) UpdateRoleManagementPermissions(ctx context.Context, token, realm, roleID string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	return g.updateManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "roles-by-id", roleID, "management", "permissions"), permissions)
+}
+
+// GetIdentityProviderManagementPermissions returns the fine grained admin permissions of an identity provider
+func (g *GoKeycloak) GetIdentityProviderManagementPermissions(ctx context.Context, token, realm, alias string) (int, *ManagementPermissionReference, error) {
+	return g.getManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "management", "permissions"))
+}
+
+// UpdateIdentityProviderManagementPermissions enables or disables the fine grained admin permissions of an identity provider
+func (g *GoKeycloak) UpdateIdentityProviderManagementPermissions(ctx context.Context, token, realm, alias string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	return g.updateManagementPermissions(ctx, token, g.getAdminRealmURL(realm, "identity-provider", "instances", alias, "management", "permissions"), permissions)
+}
+
+func (g *GoKeycloak) getManagementPermissions(ctx context.Context, token, url string) (int, *ManagementPermissionReference, error) {
+	const errMessage = "could not get management permissions"
+
+	var result ManagementPermissionReference
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(url)
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+func (g *GoKeycloak) updateManagementPermissions(ctx context.Context, token, url string, permissions ManagementPermissionReference) (int, *ManagementPermissionReference, error) {
+	const errMessage = "could not update management permissions"
+
+	var result ManagementPermissionReference
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetBody(ManagementPermissionReference{Enabled: permissions.Enabled}).
+		Put(url)
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// GetRealmManagementClientID returns the id of the realm-management client, whose resource server holds the
+// fine grained admin permissions and the policies they are linked to, e.g. for CreatePolicy
+func (g *GoKeycloak) GetRealmManagementClientID(ctx context.Context, token, realm string) (string, error) {
+	_, clients, err := g.GetClients(ctx, token, realm, GetClientsParams{ClientID: StringP(realmManagementClientID)})
+	if err != nil {
+		return "", err
+	}
+	for _, client := range clients {
+		if PString(client.ClientID) == realmManagementClientID {
+			return PString(client.ID), nil
+		}
+	}
+
+	return "", errors.Errorf("client %s not found in realm %s", realmManagementClientID, realm)
+}
+
+// LinkManagementPermissionPolicies adds policies of the realm-management client to the scope permission generated
+// for the scope, e.g. manage-members of a group. The policies already linked and the resources and scopes of the
+// permission are kept. The permissions must be enabled, e.g. by UpdateGroupManagementPermissions.
+func (g *GoKeycloak) LinkManagementPermissionPolicies(ctx context.Context, token, realm string, permissions ManagementPermissionReference, scope string, policyIDs ...string) error {
+	const errMessage = "could not link management permission policies"
+
+	if permissions.ScopePermissions == nil || (*permissions.ScopePermissions)[scope] == "" {
+		return errors.Errorf("%s: no permission for scope %s, are the management permissions enabled?", errMessage, scope)
+	}
+	permissionID := (*permissions.ScopePermissions)[scope]
+
+	idOfClient, err := g.GetRealmManagementClientID(ctx, token, realm)
+	if err != nil {
+		return err
+	}
+	permission, err := g.GetPermission(ctx, token, realm, idOfClient, permissionID)
+	if err != nil {
+		return err
+	}
+	resources, err := g.GetPermissionResources(ctx, token, realm, idOfClient, permissionID)
+	if err != nil {
+		return err
+	}
+	scopes, err := g.GetPermissionScopes(ctx, token, realm, idOfClient, permissionID)
+	if err != nil {
+		return err
+	}
+	associated, err := g.GetAuthorizationPolicyAssociatedPolicies(ctx, token, realm, idOfClient, permissionID)
+	if err != nil {
+		return err
+	}
+
+	resourceIDs := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, PString(resource.ResourceID))
+	}
+	scopeIDs := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		scopeIDs = append(scopeIDs, PString(s.ScopeID))
+	}
+	policies := make([]string, 0, len(associated)+len(policyIDs))
+	for _, policy := range associated {
+		policies = append(policies, PString(policy.ID))
+	}
+	for _, policyID := range policyIDs {
+		if !containsString(policies, policyID) {
+			policies = append(policies, policyID)
+		}
+	}
+
+	permission.Resources, permission.Scopes, permission.Policies = &resourceIDs, &scopeIDs, &policies
+	if NilOrEmpty(permission.Type) {
+		permission.Type = StringP("scope")
+	}
+	return g.UpdatePermission(ctx, token, realm, idOfClient, *permission)
+}
@@ -0,0 +1,108 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeManagementPermissions serves the management permissions of group group-id, it returns the last update of
+// the manage-members scope permission
+func fakeManagementPermissions(t *testing.T) (*fakeServer, func() gokeycloak.PermissionRepresentation) {
+	t.Helper()
+
+	var mu sync.Mutex
+	enabled := false
+	var updated gokeycloak.PermissionRepresentation
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		const authz = "/admin/realms/test/clients/rm-id/authz/resource-server/"
+		switch r.Method + " " + r.URL.Path {
+		case "PUT /admin/realms/test/groups/group-id/management/permissions":
+			var body map[string]interface{}
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			enabled, _ = body["enabled"].(bool)
+			fallthrough
+		case "GET /admin/realms/test/groups/group-id/management/permissions":
+			reference := gokeycloak.ManagementPermissionReference{Enabled: gokeycloak.BoolP(enabled)}
+			if enabled {
+				reference.Resource = gokeycloak.StringP("resource-id")
+				reference.ScopePermissions = &map[string]string{"manage-members": "permission-id", "view": "view-id"}
+			}
+			writeJSON(w, reference)
+		case "GET /admin/realms/test/clients":
+			writeJSON(w, []gokeycloak.Client{{ID: gokeycloak.StringP("rm-id"), ClientID: gokeycloak.StringP("realm-management")}})
+		case "GET " + authz + "permission/permission-id":
+			writeJSON(w, gokeycloak.PermissionRepresentation{
+				ID: gokeycloak.StringP("permission-id"), Name: gokeycloak.StringP("manage.members.permission.group.group-id"),
+				Type: gokeycloak.StringP("scope"), DecisionStrategy: gokeycloak.UNANIMOUS,
+			})
+		case "GET " + authz + "permission/permission-id/resources":
+			writeJSON(w, []gokeycloak.PermissionResource{{ResourceID: gokeycloak.StringP("resource-id")}})
+		case "GET " + authz + "permission/permission-id/scopes":
+			writeJSON(w, []gokeycloak.PermissionScope{{ScopeID: gokeycloak.StringP("scope-id")}})
+		case "GET " + authz + "policy/permission-id/associatedPolicies":
+			writeJSON(w, []gokeycloak.PolicyRepresentation{{ID: gokeycloak.StringP("existing-policy")}})
+		case "PUT " + authz + "permission/scope/permission-id":
+			_ = json.NewDecoder(r.Body).Decode(&updated)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	return server, func() gokeycloak.PermissionRepresentation {
+		mu.Lock()
+		defer mu.Unlock()
+		return updated
+	}
+}
+
+func Test_ManagementPermissions(t *testing.T) {
+	t.Parallel()
+
+	server, _ := fakeManagementPermissions(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, reference, err := client.GetGroupManagementPermissions(ctx, "token", "test", "group-id")
+	require.NoError(t, err)
+	require.False(t, *reference.Enabled)
+	err = client.LinkManagementPermissionPolicies(ctx, "token", "test", *reference, "manage-members", "policy-id")
+	require.Error(t, err, "disabled permissions have no scope permissions")
+
+	_, reference, err = client.UpdateGroupManagementPermissions(ctx, "token", "test", "group-id", gokeycloak.ManagementPermissionReference{Enabled: gokeycloak.BoolP(true)})
+	require.NoError(t, err)
+	require.True(t, *reference.Enabled)
+	require.Equal(t, "permission-id", (*reference.ScopePermissions)["manage-members"])
+
+	_, _, err = client.GetRoleManagementPermissions(ctx, "token", "test", "missing")
+	require.Error(t, err)
+}
+
+func Test_LinkManagementPermissionPolicies(t *testing.T) {
+	t.Parallel()
+
+	server, updated := fakeManagementPermissions(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, reference, err := client.UpdateGroupManagementPermissions(ctx, "token", "test", "group-id", gokeycloak.ManagementPermissionReference{Enabled: gokeycloak.BoolP(true)})
+	require.NoError(t, err)
+
+	err = client.LinkManagementPermissionPolicies(ctx, "token", "test", *reference, "manage-members", "policy-id", "existing-policy")
+	require.NoError(t, err)
+	require.Equal(t, "realm-management", server.lastRequest(t, http.MethodGet, "/admin/realms/test/clients").Query.Get("clientId"))
+	permission := updated()
+	require.Equal(t, []string{"existing-policy", "policy-id"}, *permission.Policies)
+	require.Equal(t, []string{"resource-id"}, *permission.Resources)
+	require.Equal(t, []string{"scope-id"}, *permission.Scopes)
+	require.Equal(t, gokeycloak.UNANIMOUS, permission.DecisionStrategy)
+}
@@ -3,6 +3,7 @@ package gokeycloak_test
 import (
 	"encoding/json"
 	"errors"
+	"net/url"
 	"testing"
 
 	"github.com/zblocks/gokeycloak"
@@ -134,6 +135,36 @@ func TestGetQueryParams(t *testing.T) {
 	)
 }
 
+func TestGetQueryValues(t *testing.T) {
+	t.Parallel()
+
+	type TestParams struct {
+		IntField    *int     `json:"int_field,string,omitempty"`
+		StringField *string  `json:"string_field,omitempty"`
+		SliceField  []string `json:"slice_field,omitempty"`
+	}
+
+	params, err := gokeycloak.GetQueryValues(TestParams{})
+	assert.NoError(t, err)
+	assert.Empty(t, params)
+
+	params, err = gokeycloak.GetQueryValues(TestParams{
+		IntField:    gokeycloak.IntP(1),
+		StringField: gokeycloak.StringP("fake"),
+		SliceField:  []string{"a", "b"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		url.Values{
+			"int_field":    {"1"},
+			"string_field": {"fake"},
+			"slice_field":  {"a", "b"},
+		},
+		params,
+	)
+}
+
 func TestParseAPIErrType(t *testing.T) {
 	testCases := []struct {
 		Name     string
@@ -341,3 +372,45 @@ func TestStringerOmitEmpty(t *testing.T) {
 		assert.Equal(t, "{}", custom.String())
 	}
 }
+
+func TestParseRealm(t *testing.T) {
+	t.Parallel()
+
+	realm, err := gokeycloak.ParseRealm([]byte(`{"realm": "test", "clients": [
+		{"clientId": "app", "redirectUris": ["https://a"], "protocolMappers": [{"name": "m", "protocolMapper": "p"}]},
+		{"client_id": "dcr", "redirect_uris": ["https://b"], "protocol_mappers": [{"name": "m", "protocol_mapper": "p"}]}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "test", gokeycloak.PString(realm.Realm))
+	for i, clientID := range []string{"app", "dcr"} {
+		client := (*realm.Clients)[i]
+		assert.Equal(t, clientID, gokeycloak.PString(client.ClientID))
+		assert.Len(t, gokeycloak.PStringSlice(client.RedirectURIs), 1)
+		assert.Len(t, *client.ProtocolMappers, 1)
+		assert.Equal(t, "p", gokeycloak.PString((*client.ProtocolMappers)[0].ProtocolMapper))
+	}
+
+	var client gokeycloak.Client
+	assert.NoError(t, json.Unmarshal([]byte(`{"clientId": "app", "client_id": "dcr"}`), &client))
+	assert.Equal(t, "dcr", gokeycloak.PString(client.ClientID), "clients are still decoded for the client registration API")
+}
+
+func TestRealmRepresentation_BruteForceSettings(t *testing.T) {
+	t.Parallel()
+
+	var realm gokeycloak.RealmRepresentation
+	assert.NoError(t, json.Unmarshal([]byte(`{"realm": "test", "bruteForceProtected": true, "failureFactor": 3, "quickLoginCheckMilliSeconds": 1000}`), &realm))
+
+	settings := realm.BruteForceSettings()
+	assert.True(t, gokeycloak.PBool(settings.BruteForceProtected))
+	assert.Equal(t, 3, gokeycloak.PInt(settings.FailureFactor))
+	assert.Equal(t, int64(1000), gokeycloak.PInt64(settings.QuickLoginCheckMilliSeconds))
+	assert.Nil(t, settings.PermanentLockout)
+
+	settings.PermanentLockout = gokeycloak.BoolP(true)
+	settings.MaxTemporaryLockouts = gokeycloak.IntP(2)
+	realm.SetBruteForceSettings(settings)
+	data, err := json.Marshal(realm)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"realm": "test", "bruteForceProtected": true, "failureFactor": 3, "quickLoginCheckMilliSeconds": 1000,
+		"permanentLockout": true, "maxTemporaryLockouts": 2}`, string(data))
+}
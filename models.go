@@ -3,9 +3,14 @@ package gokeycloak
 import (
 	"bytes"
 	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
 )
 
 // GetQueryParams converts the struct to map[string]string
@@ -31,6 +36,26 @@ func GetQueryParams(s interface{}) (map[string]string, error) {
 	return res, nil
 }
 
+// GetQueryValues converts the struct to url.Values
+// It follows the tag rules of GetQueryParams, but also supports []string fields,
+// which are added as repeated query parameters.
+func GetQueryValues(s interface{}) (url.Values, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	var res map[string]StringOrArray
+	err = json.Unmarshal(b, &res)
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	for key, value := range res {
+		values[key] = value
+	}
+	return values, nil
+}
+
 // StringOrArray represents a value that can either be a string or an array of strings
 type StringOrArray []string
 
@@ -99,6 +124,10 @@ const (
 	// APIErrTypeInvalidGrant corresponds with Keycloak's
 	// OAuthErrorException due to "invalid_grant".
 	APIErrTypeInvalidGrant = "oauth: invalid grant"
+
+	// APIErrTypeImpersonationForbidden is set if the caller lacks the
+	// permission to impersonate the user.
+	APIErrTypeImpersonationForbidden APIErrType = "impersonation forbidden"
 )
 
 // ParseAPIErrType is a convenience method for returning strongly
@@ -120,6 +149,7 @@ type APIError struct {
 	Code    int        `json:"code"`
 	Message string     `json:"message"`
 	Type    APIErrType `json:"type"`
+	cause   error
 }
 
 // Error stringifies the APIError
@@ -127,6 +157,11 @@ func (apiError APIError) Error() string {
 	return apiError.Message
 }
 
+// Unwrap returns the error which caused the APIError, if any
+func (apiError APIError) Unwrap() error {
+	return apiError.cause
+}
+
 // CertResponseKey is returned by the certs endpoint.
 // JSON Web Key structure is described here:
 // https://self-issued.info/docs/draft-ietf-jose-json-web-key.html#JWKContents
@@ -719,6 +754,195 @@ type RolesRepresentation struct {
 	Realm  *[]Role            `json:"realm,omitempty"`
 }
 
+// UnmanagedAttributePolicy is an enum type for the handling of attributes which are not part of the user profile
+type UnmanagedAttributePolicy string
+
+// UnmanagedAttributePolicy values
+var (
+	UnmanagedAttributesEnabled   = UnmanagedAttributePolicyP("ENABLED")
+	UnmanagedAttributesAdminView = UnmanagedAttributePolicyP("ADMIN_VIEW")
+	UnmanagedAttributesAdminEdit = UnmanagedAttributePolicyP("ADMIN_EDIT")
+)
+
+// UserProfileConfig represents the declarative user profile of a realm
+type UserProfileConfig struct {
+	Attributes               *[]UserProfileAttribute   `json:"attributes,omitempty"`
+	Groups                   *[]UserProfileGroup       `json:"groups,omitempty"`
+	UnmanagedAttributePolicy *UnmanagedAttributePolicy `json:"unmanagedAttributePolicy,omitempty"`
+}
+
+// UserProfileAttribute represents an attribute of the user profile
+type UserProfileAttribute struct {
+	Name        *string                            `json:"name,omitempty"`
+	DisplayName *string                            `json:"displayName,omitempty"`
+	Validations *map[string]map[string]interface{} `json:"validations,omitempty"`
+	Annotations *map[string]interface{}            `json:"annotations,omitempty"`
+	Required    *UserProfileAttributeRequired      `json:"required,omitempty"`
+	Permissions *UserProfileAttributePermissions   `json:"permissions,omitempty"`
+	Selector    *UserProfileAttributeSelector      `json:"selector,omitempty"`
+	Group       *string                            `json:"group,omitempty"`
+	Multivalued *bool                              `json:"multivalued,omitempty"`
+}
+
+// UserProfileAttributeRequired represents when an attribute is required, always if roles and scopes are empty
+type UserProfileAttributeRequired struct {
+	Roles  *[]string `json:"roles,omitempty"`
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// UserProfileAttributePermissions represents the roles (admin, user) which may view and edit an attribute
+type UserProfileAttributePermissions struct {
+	View *[]string `json:"view,omitempty"`
+	Edit *[]string `json:"edit,omitempty"`
+}
+
+// UserProfileAttributeSelector represents the scopes for which an attribute is enabled
+type UserProfileAttributeSelector struct {
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// UserProfileGroup represents a group of attributes of the user profile
+type UserProfileGroup struct {
+	Name               *string                 `json:"name,omitempty"`
+	DisplayHeader      *string                 `json:"displayHeader,omitempty"`
+	DisplayDescription *string                 `json:"displayDescription,omitempty"`
+	Annotations        *map[string]interface{} `json:"annotations,omitempty"`
+}
+
+// UserProfileMetadata represents the user profile as resolved for the admin context
+type UserProfileMetadata struct {
+	Attributes *[]UserProfileAttributeMetadata      `json:"attributes,omitempty"`
+	Groups     *[]UserProfileAttributeGroupMetadata `json:"groups,omitempty"`
+}
+
+// UserProfileAttributeMetadata represents the resolved metadata of a user profile attribute
+type UserProfileAttributeMetadata struct {
+	Name        *string                            `json:"name,omitempty"`
+	DisplayName *string                            `json:"displayName,omitempty"`
+	Required    *bool                              `json:"required,omitempty"`
+	ReadOnly    *bool                              `json:"readOnly,omitempty"`
+	Annotations *map[string]interface{}            `json:"annotations,omitempty"`
+	Validators  *map[string]map[string]interface{} `json:"validators,omitempty"`
+	Group       *string                            `json:"group,omitempty"`
+	Multivalued *bool                              `json:"multivalued,omitempty"`
+}
+
+// UserProfileAttributeGroupMetadata represents the resolved metadata of a user profile group
+type UserProfileAttributeGroupMetadata struct {
+	Name               *string                 `json:"name,omitempty"`
+	DisplayHeader      *string                 `json:"displayHeader,omitempty"`
+	DisplayDescription *string                 `json:"displayDescription,omitempty"`
+	Annotations        *map[string]interface{} `json:"annotations,omitempty"`
+}
+
+// ImpersonationRepresentation is the result of impersonating a user
+type ImpersonationRepresentation struct {
+	SameRealm *bool   `json:"sameRealm,omitempty"`
+	Redirect  *string `json:"redirect,omitempty"`
+	// Cookies are the session cookies of the impersonated user, a browser needs them to follow the redirect
+	Cookies []*http.Cookie `json:"-"`
+}
+
+// ImpersonationOptions are the parameters of ImpersonateUserWithTokenExchange
+type ImpersonationOptions struct {
+	// ClientID and ClientSecret are the credentials of the client which is allowed to exchange tokens
+	ClientID     string
+	ClientSecret string
+	// SubjectToken is the access token of the impersonating user
+	SubjectToken string
+	// RequestedSubject is the id or the username of the impersonated user
+	RequestedSubject string
+	// Audience is the client the token is issued for, the exchanging client if empty
+	Audience string
+	// Scope is the requested scope, optional
+	Scope string
+	// Reason is recorded in the audit record, e.g. a support ticket
+	Reason string
+}
+
+// ImpersonationAudit records who impersonated whom, why and when
+type ImpersonationAudit struct {
+	Impersonator         string    `json:"impersonator"`
+	ImpersonatorUsername string    `json:"impersonatorUsername,omitempty"`
+	Subject              string    `json:"subject"`
+	ClientID             string    `json:"clientId"`
+	Audience             string    `json:"audience,omitempty"`
+	Reason               string    `json:"reason,omitempty"`
+	Time                 time.Time `json:"time"`
+}
+
+// ImpersonationToken is the result of ImpersonateUserWithTokenExchange
+type ImpersonationToken struct {
+	Token *JWT
+	Audit ImpersonationAudit
+}
+
+// PartialExportParams represents the optional parameters for a partial export of a realm
+type PartialExportParams struct {
+	ExportClients        *bool `json:"exportClients,string,omitempty"`
+	ExportGroupsAndRoles *bool `json:"exportGroupsAndRoles,string,omitempty"`
+}
+
+// PartialImportPolicy is an enum type for the handling of existing resources during a partial import
+type PartialImportPolicy string
+
+// PartialImportPolicy values
+var (
+	PartialImportFail      = PartialImportPolicyP("FAIL")
+	PartialImportSkip      = PartialImportPolicyP("SKIP")
+	PartialImportOverwrite = PartialImportPolicyP("OVERWRITE")
+)
+
+// PartialImportRepresentation represents the resources of a partial import
+type PartialImportRepresentation struct {
+	IfResourceExists  *PartialImportPolicy              `json:"ifResourceExists,omitempty"`
+	Users             *[]User                           `json:"users,omitempty"`
+	Clients           *[]Client                         `json:"clients,omitempty"`
+	Groups            *[]Group                          `json:"groups,omitempty"`
+	IdentityProviders *[]IdentityProviderRepresentation `json:"identityProviders,omitempty"`
+	Roles             *RolesRepresentation              `json:"roles,omitempty"`
+}
+
+// PartialImportAction is an enum type for the action taken for a resource during a partial import
+type PartialImportAction string
+
+// PartialImportAction values
+var (
+	PartialImportAdded       = PartialImportActionP("ADDED")
+	PartialImportSkipped     = PartialImportActionP("SKIPPED")
+	PartialImportOverwritten = PartialImportActionP("OVERWRITTEN")
+)
+
+// PartialImportResultItem represents a single imported resource
+type PartialImportResultItem struct {
+	Action       *PartialImportAction `json:"action,omitempty"`
+	ResourceType *string              `json:"resourceType,omitempty"`
+	ResourceName *string              `json:"resourceName,omitempty"`
+	ID           *string              `json:"id,omitempty"`
+}
+
+// PartialImportResult is the result of a partial import
+type PartialImportResult struct {
+	Added       *int                       `json:"added,omitempty"`
+	Skipped     *int                       `json:"skipped,omitempty"`
+	Overwritten *int                       `json:"overwritten,omitempty"`
+	Results     *[]PartialImportResultItem `json:"results,omitempty"`
+}
+
+// Items returns the imported resources the given action was taken for
+func (r *PartialImportResult) Items(action PartialImportAction) []PartialImportResultItem {
+	var items []PartialImportResultItem
+	if r.Results == nil {
+		return items
+	}
+	for _, item := range *r.Results {
+		if item.Action != nil && *item.Action == action {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 // RealmRepresentation represents a realm
 type RealmRepresentation struct {
 	AccessCodeLifespan                                        *int                 `json:"accessCodeLifespan,omitempty"`
@@ -774,6 +998,7 @@ type RealmRepresentation struct {
 	LoginWithEmailAllowed                                     *bool                `json:"loginWithEmailAllowed,omitempty"`
 	MaxDeltaTimeSeconds                                       *int                 `json:"maxDeltaTimeSeconds,omitempty"`
 	MaxFailureWaitSeconds                                     *int                 `json:"maxFailureWaitSeconds,omitempty"`
+	MaxTemporaryLockouts                                      *int                 `json:"maxTemporaryLockouts,omitempty"`
 	MinimumQuickLoginWaitSeconds                              *int                 `json:"minimumQuickLoginWaitSeconds,omitempty"`
 	NotBefore                                                 *int                 `json:"notBefore,omitempty"`
 	OfflineSessionIdleTimeout                                 *int                 `json:"offlineSessionIdleTimeout,omitempty"`
@@ -890,6 +1115,86 @@ type ModifyAuthenticationExecutionRepresentation struct {
 	Description          *string   `json:"description"`
 }
 
+// AuthenticatorConfigRepresentation represents the configuration of an authentication execution
+type AuthenticatorConfigRepresentation struct {
+	ID     *string            `json:"id,omitempty"`
+	Alias  *string            `json:"alias,omitempty"`
+	Config *map[string]string `json:"config,omitempty"`
+}
+
+// AuthenticatorConfigInfoRepresentation describes the configuration properties of an authenticator
+type AuthenticatorConfigInfoRepresentation struct {
+	Name       *string                         `json:"name,omitempty"`
+	ProviderID *string                         `json:"providerId,omitempty"`
+	HelpText   *string                         `json:"helpText,omitempty"`
+	Properties *[]ConfigPropertyRepresentation `json:"properties,omitempty"`
+}
+
+// ConfigPropertyRepresentation describes a configuration property of a provider
+type ConfigPropertyRepresentation struct {
+	Name         *string     `json:"name,omitempty"`
+	Label        *string     `json:"label,omitempty"`
+	HelpText     *string     `json:"helpText,omitempty"`
+	Type         *string     `json:"type,omitempty"`
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+	Options      *[]string   `json:"options,omitempty"`
+	Secret       *bool       `json:"secret,omitempty"`
+	Required     *bool       `json:"required,omitempty"`
+	ReadOnly     *bool       `json:"readOnly,omitempty"`
+}
+
+// AuthenticationProviderRepresentation describes an authenticator, form, form action or client authenticator provider
+type AuthenticationProviderRepresentation struct {
+	ID          *string `json:"id,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ClientPoliciesRepresentation represents the client policies of a realm
+type ClientPoliciesRepresentation struct {
+	Policies *[]ClientPolicyDefinition `json:"policies,omitempty"`
+	// GlobalPolicies are the built in policies, they are only returned and can not be updated
+	GlobalPolicies *[]ClientPolicyDefinition `json:"globalPolicies,omitempty"`
+}
+
+// ClientPolicyDefinition represents a client policy of the client policies feature, which applies its profiles
+// to the clients matching its conditions. It is called ClientPolicyRepresentation by Keycloak, unlike the
+// client based authorization policy ClientPolicyRepresentation.
+type ClientPolicyDefinition struct {
+	Name        *string                                `json:"name,omitempty"`
+	Description *string                                `json:"description,omitempty"`
+	Enabled     *bool                                  `json:"enabled,omitempty"`
+	Conditions  *[]ClientPolicyConditionRepresentation `json:"conditions,omitempty"`
+	// Profiles are the names of the client profiles of the policy
+	Profiles *[]string `json:"profiles,omitempty"`
+}
+
+// ClientPolicyConditionRepresentation represents a condition of a client policy, e.g. client-access-type
+type ClientPolicyConditionRepresentation struct {
+	Condition     *string                 `json:"condition,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+}
+
+// ClientProfilesRepresentation represents the client profiles of a realm
+type ClientProfilesRepresentation struct {
+	Profiles *[]ClientProfileRepresentation `json:"profiles,omitempty"`
+	// GlobalProfiles are the built in profiles, they are only returned and can not be updated
+	GlobalProfiles *[]ClientProfileRepresentation `json:"globalProfiles,omitempty"`
+}
+
+// ClientProfileRepresentation represents a client profile, a set of executors enforcing rules on clients
+type ClientProfileRepresentation struct {
+	Name        *string                               `json:"name,omitempty"`
+	Description *string                               `json:"description,omitempty"`
+	Executors   *[]ClientPolicyExecutorRepresentation `json:"executors,omitempty"`
+}
+
+// ClientPolicyExecutorRepresentation represents an executor of a client profile, e.g. pkce-enforcer
+type ClientPolicyExecutorRepresentation struct {
+	Executor      *string                 `json:"executor,omitempty"`
+	Configuration *map[string]interface{} `json:"configuration,omitempty"`
+}
+
 // MultiValuedHashMap represents something
 type MultiValuedHashMap struct {
 	Empty      *bool    `json:"empty,omitempty"`
@@ -1016,6 +1321,58 @@ type UserSessionRepresentation struct {
 	Username   *string            `json:"username,omitempty"`
 }
 
+// UserConsentRepresentation represents the grants of a user to a client.
+// Clients holding offline tokens of the user are listed even if they do not require consent.
+type UserConsentRepresentation struct {
+	ClientID            *string             `json:"clientId,omitempty"`
+	GrantedClientScopes *[]string           `json:"grantedClientScopes,omitempty"`
+	CreatedDate         *int64              `json:"createdDate,omitempty"`
+	LastUpdatedDate     *int64              `json:"lastUpdatedDate,omitempty"`
+	AdditionalGrants    *[]UserConsentGrant `json:"additionalGrants,omitempty"`
+}
+
+// UserConsentGrant represents an additional grant of a user consent, e.g. an offline token
+type UserConsentGrant struct {
+	Client *string `json:"client,omitempty"`
+	Key    *string `json:"key,omitempty"`
+}
+
+// offlineTokenGrant is the key of the additional grant Keycloak reports for offline tokens
+const offlineTokenGrant = "Offline Token"
+
+// HasOfflineToken returns true if the client holds offline tokens of the user
+func (c *UserConsentRepresentation) HasOfflineToken() bool {
+	if c.AdditionalGrants == nil {
+		return false
+	}
+	for _, grant := range *c.AdditionalGrants {
+		if PString(grant.Key) == offlineTokenGrant {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientSessionStats represents the number of sessions of a client, see GetClientSessionStats
+type ClientSessionStats struct {
+	ID       *string `json:"id,omitempty"`
+	ClientID *string `json:"clientId,omitempty"`
+	Active   *int64  `json:"active,string,omitempty"`
+	Offline  *int64  `json:"offline,string,omitempty"`
+}
+
+// GlobalRequestResult represents the result of a request Keycloak pushes to the admin URLs of the clients
+type GlobalRequestResult struct {
+	SuccessRequests *[]string `json:"successRequests,omitempty"`
+	FailedRequests  *[]string `json:"failedRequests,omitempty"`
+}
+
+// GetSessionsParams represents the optional parameters for listing the sessions of a client
+type GetSessionsParams struct {
+	First *int `json:"first,string,omitempty"`
+	Max   *int `json:"max,string,omitempty"`
+}
+
 // SystemInfoRepresentation represents a system info
 type SystemInfoRepresentation struct {
 	FileEncoding   *string `json:"fileEncoding,omitempty"`
@@ -1101,6 +1458,19 @@ type ServerInfoRepresentation struct {
 	ProtocolMapperTypes    *ProtocolMapperTypes      `json:"protocolMapperTypes,omitempty"`
 	BuiltinProtocolMappers *BuiltinProtocolMappers   `json:"builtinProtocolMappers,omitempty"`
 	Themes                 *Themes                   `json:"themes,omitempty"`
+	// ClientInstallations lists the installation providers by protocol, e.g. openid-connect
+	ClientInstallations map[string][]ClientInstallationProvider `json:"clientInstallations,omitempty"`
+}
+
+// ClientInstallationProvider describes a provider rendering client installations, see GetClientInstallation
+type ClientInstallationProvider struct {
+	ID           string `json:"id,omitempty"`
+	Protocol     string `json:"protocol,omitempty"`
+	DownloadOnly bool   `json:"downloadOnly,omitempty"`
+	DisplayType  string `json:"displayType,omitempty"`
+	HelpText     string `json:"helpText,omitempty"`
+	Filename     string `json:"filename,omitempty"`
+	MediaType    string `json:"mediaType,omitempty"`
 }
 
 // ThemeRepresentation contains the theme name and locales
@@ -1214,6 +1584,133 @@ type PermissionRepresentation struct {
 	Type             *string           `json:"type,omitempty"`
 }
 
+// CertificateRepresentation is a certificate of a client with its keys, e.g. to verify the jwts of LoginClientSignedJWT
+type CertificateRepresentation struct {
+	PrivateKey  *string `json:"privateKey,omitempty"`
+	PublicKey   *string `json:"publicKey,omitempty"`
+	Certificate *string `json:"certificate,omitempty"`
+	Kid         *string `json:"kid,omitempty"`
+}
+
+// KeyStoreFormat is an enum type for the formats of downloaded and uploaded client keys
+type KeyStoreFormat string
+
+// KeyStoreFormat values, downloads support JKS and PKCS12 only
+var (
+	KeyStoreFormatJKS            = KeyStoreFormatP("JKS")
+	KeyStoreFormatPKCS12         = KeyStoreFormatP("PKCS12")
+	KeyStoreFormatCertificatePEM = KeyStoreFormatP("Certificate PEM")
+	KeyStoreFormatPublicKeyPEM   = KeyStoreFormatP("Public Key PEM")
+	KeyStoreFormatJSONWebKeySet  = KeyStoreFormatP("JSON Web Key Set")
+)
+
+// ClientKeyStoreConfig represents the keystore of a downloaded client certificate
+type ClientKeyStoreConfig struct {
+	RealmCertificate *bool           `json:"realmCertificate,omitempty"`
+	StorePassword    *string         `json:"storePassword,omitempty"`
+	KeyPassword      *string         `json:"keyPassword,omitempty"`
+	KeyAlias         *string         `json:"keyAlias,omitempty"`
+	RealmAlias       *string         `json:"realmAlias,omitempty"`
+	Format           *KeyStoreFormat `json:"format,omitempty"`
+}
+
+// UploadCertificateParams represents the format and passwords of an uploaded client certificate
+type UploadCertificateParams struct {
+	KeyStoreFormat *KeyStoreFormat `json:"keystoreFormat,omitempty"`
+	KeyAlias       *string         `json:"keyAlias,omitempty"`
+	KeyPassword    *string         `json:"keyPassword,omitempty"`
+	StorePassword  *string         `json:"storePassword,omitempty"`
+}
+
+// FormData returns a map of options to be used in SetFormData function
+func (p *UploadCertificateParams) FormData() map[string]string {
+	m, _ := json.Marshal(p)
+	var res map[string]string
+	_ = json.Unmarshal(m, &res)
+	return res
+}
+
+// SAMLEntityDescriptor is the SAML metadata of an identity or service provider
+type SAMLEntityDescriptor struct {
+	XMLName          xml.Name
+	EntityID         string             `xml:"entityID,attr"`
+	IDPSSODescriptor *SAMLSSODescriptor `xml:"IDPSSODescriptor"`
+	SPSSODescriptor  *SAMLSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+// SAMLSSODescriptor is the SAML metadata of the single sign on role of a provider
+type SAMLSSODescriptor struct {
+	ProtocolSupportEnumeration string              `xml:"protocolSupportEnumeration,attr"`
+	AuthnRequestsSigned        *bool               `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       *bool               `xml:"WantAssertionsSigned,attr"`
+	WantAuthnRequestsSigned    *bool               `xml:"WantAuthnRequestsSigned,attr"`
+	KeyDescriptors             []SAMLKeyDescriptor `xml:"KeyDescriptor"`
+	NameIDFormats              []string            `xml:"NameIDFormat"`
+	SingleSignOnServices       []SAMLEndpoint      `xml:"SingleSignOnService"`
+	SingleLogoutServices       []SAMLEndpoint      `xml:"SingleLogoutService"`
+	AssertionConsumerServices  []SAMLEndpoint      `xml:"AssertionConsumerService"`
+	ArtifactResolutionServices []SAMLEndpoint      `xml:"ArtifactResolutionService"`
+}
+
+// SAMLKeyDescriptor is a base64 encoded certificate of a provider, Use is signing, encryption or empty for both
+type SAMLKeyDescriptor struct {
+	Use         string `xml:"use,attr"`
+	KeyName     string `xml:"KeyInfo>KeyName"`
+	Certificate string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+// SAMLEndpoint is a SAML endpoint of a provider
+type SAMLEndpoint struct {
+	Binding          string `xml:"Binding,attr"`
+	Location         string `xml:"Location,attr"`
+	ResponseLocation string `xml:"ResponseLocation,attr,omitempty"`
+	Index            *int   `xml:"index,attr"`
+	IsDefault        *bool  `xml:"isDefault,attr"`
+}
+
+// ProtocolMapperEvaluationRepresentation is a protocol mapper applied to the tokens of a client,
+// the container is the client scope or client the mapper belongs to
+type ProtocolMapperEvaluationRepresentation struct {
+	MapperID       *string `json:"mapperId,omitempty"`
+	MapperName     *string `json:"mapperName,omitempty"`
+	ContainerID    *string `json:"containerId,omitempty"`
+	ContainerName  *string `json:"containerName,omitempty"`
+	ContainerType  *string `json:"containerType,omitempty"`
+	ProtocolMapper *string `json:"protocolMapper,omitempty"`
+}
+
+// SynchronizationResult holds the counts of a user storage or mapper synchronization
+type SynchronizationResult struct {
+	Ignored *bool   `json:"ignored,omitempty"`
+	Added   *int    `json:"added,omitempty"`
+	Updated *int    `json:"updated,omitempty"`
+	Removed *int    `json:"removed,omitempty"`
+	Failed  *int    `json:"failed,omitempty"`
+	Status  *string `json:"status,omitempty"`
+}
+
+// TestLDAPConnectionRepresentation represents the settings of a LDAP connection test,
+// with the ComponentID of a stored provider its stored bind credential is used
+type TestLDAPConnectionRepresentation struct {
+	Action            *string `json:"action,omitempty"`
+	ConnectionURL     *string `json:"connectionUrl,omitempty"`
+	AuthType          *string `json:"authType,omitempty"`
+	BindDN            *string `json:"bindDn,omitempty"`
+	BindCredential    *string `json:"bindCredential,omitempty"`
+	UseTruststoreSpi  *string `json:"useTruststoreSpi,omitempty"`
+	ConnectionTimeout *string `json:"connectionTimeout,omitempty"`
+	ComponentID       *string `json:"componentId,omitempty"`
+	StartTLS          *string `json:"startTls,omitempty"`
+}
+
+// ManagementPermissionReference represents the fine grained admin permissions of a user, group, client, role or identity provider
+type ManagementPermissionReference struct {
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Resource *string `json:"resource,omitempty"`
+	// ScopePermissions maps the scopes, e.g. manage or view-members, to the ids of the scope permissions of the realm-management client
+	ScopePermissions *map[string]string `json:"scopePermissions,omitempty"`
+}
+
 // CreatePermissionTicketParams represents the optional parameters for getting a permission ticket
 type CreatePermissionTicketParams struct {
 	ResourceID     *string              `json:"resource_id,omitempty"`
@@ -1358,6 +1855,61 @@ type EventRepresentation struct {
 	Details   map[string]string `json:"details,omitempty"`
 }
 
+// GetAdminEventsParams represents the optional parameters for getting admin events
+type GetAdminEventsParams struct {
+	AuthClient     *string  `json:"authClient,omitempty"`
+	AuthIPAddress  *string  `json:"authIpAddress,omitempty"`
+	AuthRealm      *string  `json:"authRealm,omitempty"`
+	AuthUser       *string  `json:"authUser,omitempty"`
+	DateFrom       *string  `json:"dateFrom,omitempty"`
+	DateTo         *string  `json:"dateTo,omitempty"`
+	First          *int32   `json:"first,string,omitempty"`
+	Max            *int32   `json:"max,string,omitempty"`
+	OperationTypes []string `json:"operationTypes,omitempty"`
+	ResourcePath   *string  `json:"resourcePath,omitempty"`
+	ResourceTypes  []string `json:"resourceTypes,omitempty"`
+}
+
+// AuthDetailsRepresentation represents the authentication details of an admin event
+type AuthDetailsRepresentation struct {
+	RealmID   *string `json:"realmId,omitempty"`
+	ClientID  *string `json:"clientId,omitempty"`
+	UserID    *string `json:"userId,omitempty"`
+	IPAddress *string `json:"ipAddress,omitempty"`
+}
+
+// AdminEventRepresentation is a representation of an admin event
+type AdminEventRepresentation struct {
+	Time          int64                      `json:"time,omitempty"`
+	RealmID       *string                    `json:"realmId,omitempty"`
+	AuthDetails   *AuthDetailsRepresentation `json:"authDetails,omitempty"`
+	OperationType *string                    `json:"operationType,omitempty"`
+	ResourceType  *string                    `json:"resourceType,omitempty"`
+	ResourcePath  *string                    `json:"resourcePath,omitempty"`
+	// Representation is the JSON payload of the changed resource, only set if admin event details are enabled
+	Representation *string           `json:"representation,omitempty"`
+	Error          *string           `json:"error,omitempty"`
+	Details        map[string]string `json:"details,omitempty"`
+}
+
+// UnmarshalRepresentation decodes the JSON payload of the admin event into v
+func (e *AdminEventRepresentation) UnmarshalRepresentation(v interface{}) error {
+	if e.Representation == nil {
+		return errors.New("admin event has no representation")
+	}
+	return json.Unmarshal([]byte(*e.Representation), v)
+}
+
+// RealmEventsConfigRepresentation represents the events configuration of a realm
+type RealmEventsConfigRepresentation struct {
+	EventsEnabled             *bool     `json:"eventsEnabled,omitempty"`
+	EventsExpiration          *int64    `json:"eventsExpiration,omitempty"`
+	EventsListeners           *[]string `json:"eventsListeners,omitempty"`
+	EnabledEventTypes         *[]string `json:"enabledEventTypes,omitempty"`
+	AdminEventsEnabled        *bool     `json:"adminEventsEnabled,omitempty"`
+	AdminEventsDetailsEnabled *bool     `json:"adminEventsDetailsEnabled,omitempty"`
+}
+
 // CredentialRepresentation is a representations of the credentials
 // v7: https://www.keycloak.org/docs-api/7.0/rest-api/index.html#_credentialrepresentation
 // v8: https://www.keycloak.org/docs-api/8.0/rest-api/index.html#_credentialrepresentation
@@ -1387,6 +1939,53 @@ type CredentialRepresentation struct {
 	UserLabel      *string `json:"userLabel,omitempty"`
 }
 
+// BruteForceSettings are the brute force detection settings of a realm, see RealmRepresentation.BruteForceSettings
+type BruteForceSettings struct {
+	BruteForceProtected          *bool  `json:"bruteForceProtected,omitempty"`
+	PermanentLockout             *bool  `json:"permanentLockout,omitempty"`
+	MaxTemporaryLockouts         *int   `json:"maxTemporaryLockouts,omitempty"`
+	FailureFactor                *int   `json:"failureFactor,omitempty"`
+	WaitIncrementSeconds         *int   `json:"waitIncrementSeconds,omitempty"`
+	MaxFailureWaitSeconds        *int   `json:"maxFailureWaitSeconds,omitempty"`
+	MaxDeltaTimeSeconds          *int   `json:"maxDeltaTimeSeconds,omitempty"`
+	QuickLoginCheckMilliSeconds  *int64 `json:"quickLoginCheckMilliSeconds,omitempty"`
+	MinimumQuickLoginWaitSeconds *int   `json:"minimumQuickLoginWaitSeconds,omitempty"`
+}
+
+// BruteForceSettings returns the brute force detection settings of the realm
+func (r *RealmRepresentation) BruteForceSettings() BruteForceSettings {
+	return BruteForceSettings{
+		BruteForceProtected:          r.BruteForceProtected,
+		PermanentLockout:             r.PermanentLockout,
+		MaxTemporaryLockouts:         r.MaxTemporaryLockouts,
+		FailureFactor:                r.FailureFactor,
+		WaitIncrementSeconds:         r.WaitIncrementSeconds,
+		MaxFailureWaitSeconds:        r.MaxFailureWaitSeconds,
+		MaxDeltaTimeSeconds:          r.MaxDeltaTimeSeconds,
+		QuickLoginCheckMilliSeconds:  r.QuickLoginCheckMilliSeconds,
+		MinimumQuickLoginWaitSeconds: r.MinimumQuickLoginWaitSeconds,
+	}
+}
+
+// SetBruteForceSettings replaces the brute force detection settings of the realm, nil settings are left unchanged by UpdateRealm
+func (r *RealmRepresentation) SetBruteForceSettings(settings BruteForceSettings) {
+	r.BruteForceProtected = settings.BruteForceProtected
+	r.PermanentLockout = settings.PermanentLockout
+	r.MaxTemporaryLockouts = settings.MaxTemporaryLockouts
+	r.FailureFactor = settings.FailureFactor
+	r.WaitIncrementSeconds = settings.WaitIncrementSeconds
+	r.MaxFailureWaitSeconds = settings.MaxFailureWaitSeconds
+	r.MaxDeltaTimeSeconds = settings.MaxDeltaTimeSeconds
+	r.QuickLoginCheckMilliSeconds = settings.QuickLoginCheckMilliSeconds
+	r.MinimumQuickLoginWaitSeconds = settings.MinimumQuickLoginWaitSeconds
+}
+
+// LockedUser is a user locked out by brute force detection, see GetLockedUsers
+type LockedUser struct {
+	User   *User
+	Status *BruteForceStatus
+}
+
 // BruteForceStatus is a representation of realm user regarding brute force attack
 type BruteForceStatus struct {
 	NumFailures   *int    `json:"numFailures,omitempty"`
@@ -1488,9 +2087,15 @@ func (t *TokenOptions) String() string                              { return pre
 func (t *RequestingPartyTokenOptions) String() string               { return prettyStringStruct(t) }
 func (v *RequestingPartyPermission) String() string                 { return prettyStringStruct(v) }
 func (v *UserSessionRepresentation) String() string                 { return prettyStringStruct(v) }
+func (v *UserConsentRepresentation) String() string                 { return prettyStringStruct(v) }
+func (v *UserConsentGrant) String() string                          { return prettyStringStruct(v) }
+func (v *ClientSessionStats) String() string                        { return prettyStringStruct(v) }
+func (v *GlobalRequestResult) String() string                       { return prettyStringStruct(v) }
+func (v *GetSessionsParams) String() string                         { return prettyStringStruct(v) }
 func (v *SystemInfoRepresentation) String() string                  { return prettyStringStruct(v) }
 func (v *MemoryInfoRepresentation) String() string                  { return prettyStringStruct(v) }
 func (v *ServerInfoRepresentation) String() string                  { return prettyStringStruct(v) }
+func (v *ClientInstallationProvider) String() string                { return prettyStringStruct(v) }
 func (v *FederatedIdentityRepresentation) String() string           { return prettyStringStruct(v) }
 func (v *IdentityProviderRepresentation) String() string            { return prettyStringStruct(v) }
 func (v *GetResourceParams) String() string                         { return prettyStringStruct(v) }
@@ -1499,6 +2104,17 @@ func (v *GetPolicyParams) String() string                           { return pre
 func (v *GetPermissionParams) String() string                       { return prettyStringStruct(v) }
 func (v *GetUsersByRoleParams) String() string                      { return prettyStringStruct(v) }
 func (v *PermissionRepresentation) String() string                  { return prettyStringStruct(v) }
+func (v *ProtocolMapperEvaluationRepresentation) String() string    { return prettyStringStruct(v) }
+func (v *SynchronizationResult) String() string                     { return prettyStringStruct(v) }
+func (v *TestLDAPConnectionRepresentation) String() string          { return prettyStringStruct(v) }
+func (v *ManagementPermissionReference) String() string             { return prettyStringStruct(v) }
+func (v *CertificateRepresentation) String() string                 { return prettyStringStruct(v) }
+func (v *ClientKeyStoreConfig) String() string                      { return prettyStringStruct(v) }
+func (v *UploadCertificateParams) String() string                   { return prettyStringStruct(v) }
+func (v *SAMLEntityDescriptor) String() string                      { return prettyStringStruct(v) }
+func (v *SAMLSSODescriptor) String() string                         { return prettyStringStruct(v) }
+func (v *SAMLKeyDescriptor) String() string                         { return prettyStringStruct(v) }
+func (v *SAMLEndpoint) String() string                              { return prettyStringStruct(v) }
 func (v *CreatePermissionTicketParams) String() string              { return prettyStringStruct(v) }
 func (v *PermissionTicketDescriptionRepresentation) String() string { return prettyStringStruct(v) }
 func (v *AccessRepresentation) String() string                      { return prettyStringStruct(v) }
@@ -1513,3 +2129,30 @@ func (v *GetResourcePoliciesParams) String() string                 { return pre
 func (v *CredentialRepresentation) String() string                  { return prettyStringStruct(v) }
 func (v *RequiredActionProviderRepresentation) String() string      { return prettyStringStruct(v) }
 func (v *BruteForceStatus) String() string                          { return prettyStringStruct(v) }
+func (v *AuthenticatorConfigRepresentation) String() string         { return prettyStringStruct(v) }
+func (v *AuthenticatorConfigInfoRepresentation) String() string     { return prettyStringStruct(v) }
+func (v *ConfigPropertyRepresentation) String() string              { return prettyStringStruct(v) }
+func (v *AuthenticationProviderRepresentation) String() string      { return prettyStringStruct(v) }
+func (v *ClientPoliciesRepresentation) String() string              { return prettyStringStruct(v) }
+func (v *ClientPolicyDefinition) String() string                    { return prettyStringStruct(v) }
+func (v *ClientPolicyConditionRepresentation) String() string       { return prettyStringStruct(v) }
+func (v *ClientProfilesRepresentation) String() string              { return prettyStringStruct(v) }
+func (v *ClientProfileRepresentation) String() string               { return prettyStringStruct(v) }
+func (v *ClientPolicyExecutorRepresentation) String() string        { return prettyStringStruct(v) }
+func (v *BruteForceSettings) String() string                        { return prettyStringStruct(v) }
+func (v *LockedUser) String() string                                { return prettyStringStruct(v) }
+func (v *GetAdminEventsParams) String() string                      { return prettyStringStruct(v) }
+func (v *AuthDetailsRepresentation) String() string                 { return prettyStringStruct(v) }
+func (v *AdminEventRepresentation) String() string                  { return prettyStringStruct(v) }
+func (v *RealmEventsConfigRepresentation) String() string           { return prettyStringStruct(v) }
+func (v *UserProfileConfig) String() string                         { return prettyStringStruct(v) }
+func (v *UserProfileAttribute) String() string                      { return prettyStringStruct(v) }
+func (v *UserProfileGroup) String() string                          { return prettyStringStruct(v) }
+func (v *UserProfileMetadata) String() string                       { return prettyStringStruct(v) }
+func (v *UserProfileAttributeMetadata) String() string              { return prettyStringStruct(v) }
+func (v *UserProfileAttributeGroupMetadata) String() string         { return prettyStringStruct(v) }
+func (v *ImpersonationRepresentation) String() string               { return prettyStringStruct(v) }
+func (v *PartialExportParams) String() string                       { return prettyStringStruct(v) }
+func (v *PartialImportRepresentation) String() string               { return prettyStringStruct(v) }
+func (v *PartialImportResultItem) String() string                   { return prettyStringStruct(v) }
+func (v *PartialImportResult) String() string                       { return prettyStringStruct(v) }
@@ -34,25 +34,30 @@ func SetOpenIDConnectEndpoint(url string) func(g *GoKeycloak) {
 func (g *GoKeycloak) GetCerts(ctx context.Context, realm string) (int, *CertResponse, error) {
 	const errMessage = "could not get certs"
 
-	if cert, ok := g.certsCache.Load(realm); ok {
+	if cert, ok := g.certs.cache.Load(realm); ok {
 		return http.StatusBadRequest, cert.(*CertResponse), nil
 	}
 
-	g.certsLock.Lock()
-	defer g.certsLock.Unlock()
+	g.certs.lock.Lock()
+	defer g.certs.lock.Unlock()
 
-	if cert, ok := g.certsCache.Load(realm); ok {
+	if cert, ok := g.certs.cache.Load(realm); ok {
 		return http.StatusBadRequest, cert.(*CertResponse), nil
 	}
 
 	statusCode, cert, err := g.getNewCerts(ctx, realm)
 	if err != nil {
+		// keep validating tokens with the last known keys while Keycloak is unreachable
+		if lastKnown, ok := g.certs.lastKnown.Load(realm); ok && errors.Is(err, ErrCircuitOpen) {
+			return http.StatusOK, lastKnown.(*CertResponse), nil
+		}
 		return statusCode, nil, errors.Wrap(err, errMessage)
 	}
 
-	g.certsCache.Store(realm, cert)
+	g.certs.cache.Store(realm, cert)
+	g.certs.lastKnown.Store(realm, cert)
 	time.AfterFunc(g.Config.CertsInvalidateTime, func() {
-		g.certsCache.Delete(realm)
+		g.certs.cache.Delete(realm)
 	})
 
 	return statusCode, cert, nil
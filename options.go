@@ -0,0 +1,227 @@
+package gokeycloak
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/pkg/errors"
+)
+
+// RequestOption customizes the requests of a single call
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	header         http.Header
+	timeout        time.Duration
+	disableRetries bool
+	baseURL        string
+}
+
+var requestOptionsContextKey = contextKey("requestOptions")
+
+// WithHeader adds a header to the requests of a call
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Add(key, value)
+	}
+}
+
+// WithAcceptLanguage sets the Accept-Language header, Keycloak uses it to localize error messages
+func WithAcceptLanguage(language string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("Accept-Language", language)
+	}
+}
+
+// WithTimeout limits the duration of a call of GoKeycloakV2, including all its requests and retries.
+// Calls of GoKeycloak are limited by their context, use WithRequestOptions to add the timeout to it.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithoutRetries disables the retries configured on the resty client for a call
+func WithoutRetries() RequestOption {
+	return func(o *requestOptions) {
+		o.disableRetries = true
+	}
+}
+
+// WithBaseURL sends the requests of a call to another base URL, e.g. an internal Keycloak node
+func WithBaseURL(baseURL string) RequestOption {
+	return func(o *requestOptions) {
+		o.baseURL = strings.TrimRight(baseURL, urlSeparator)
+	}
+}
+
+// WithOptions returns a copy of the client which applies opts to the requests of all its calls, e.g.
+//
+//	client.WithOptions(WithAcceptLanguage("de"), WithoutRetries()).GetUsers(ctx, token, realm, params)
+//
+// The copy shares the resty client, the certificate cache, the circuit breaker and the hooks with g.
+// WithTimeout limits the calls of the copy's V2 surface. The calls of GoKeycloakV2 accept options directly.
+func (g *GoKeycloak) WithOptions(opts ...RequestOption) *GoKeycloak {
+	options := requestOptions{header: http.Header{}}
+	if g.options != nil {
+		options = *g.options
+		options.header = g.options.header.Clone()
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	c := *g
+	c.options = &options
+	return &c
+}
+
+// WithRequestOptions returns a context which applies opts to all requests sent with it.
+// Prefer WithOptions, it shows the options at the call. The calls of GoKeycloakV2 accept them directly.
+// Call cancel to release the resources of a timeout once the call returned.
+func WithRequestOptions(ctx context.Context, opts ...RequestOption) (context.Context, context.CancelFunc) {
+	options := requestOptions{header: http.Header{}}
+	if parent, ok := ctx.Value(requestOptionsContextKey).(*requestOptions); ok {
+		options = *parent
+		options.header = parent.header.Clone()
+	}
+	options.timeout = 0
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if options.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, options.timeout)
+	}
+
+	return context.WithValue(ctx, requestOptionsContextKey, &options), cancel
+}
+
+// requestOptions returns the options of the client combined with the options of ctx, which take precedence.
+// The timeouts are left to the calls, see newRequest.
+func (g *GoKeycloak) requestOptions(ctx context.Context) *requestOptions {
+	options, _ := ctx.Value(requestOptionsContextKey).(*requestOptions)
+	if g.options == nil || options == nil {
+		if options != nil {
+			return &requestOptions{header: options.header, disableRetries: options.disableRetries, baseURL: options.baseURL}
+		}
+		return g.options
+	}
+
+	result := *g.options
+	result.header = g.options.header.Clone()
+	for key, values := range options.header {
+		result.header[key] = values
+	}
+	result.disableRetries = result.disableRetries || options.disableRetries
+	if options.baseURL != "" {
+		result.baseURL = options.baseURL
+	}
+	return &result
+}
+
+var requestStateContextKey = contextKey("requestState")
+
+// requestState tracks a request created by newRequest with request options
+type requestState struct {
+	g       *GoKeycloak
+	options *requestOptions
+	attempt int
+	// cancel ends a request without retries, see stopRetries
+	cancel context.CancelFunc
+
+	mu             sync.Mutex
+	retriesStopped bool
+	retryErr       error
+}
+
+// newRequest creates a request on the resty client, honoring the request options of the client and of ctx.
+// The timeout of the options is not applied, it limits a call of GoKeycloakV2 or the context of WithRequestOptions.
+func (g *GoKeycloak) newRequest(ctx context.Context) *resty.Request {
+	g.guardTransport()
+	options := g.requestOptions(ctx)
+	if options == nil {
+		return g.restyClient.R().SetContext(ctx)
+	}
+
+	state := &requestState{g: g, options: options}
+	if options.disableRetries && g.restyClient.RetryCount > 0 {
+		ctx, state.cancel = context.WithCancel(ctx)
+	}
+
+	req := g.restyClient.R().SetContext(context.WithValue(ctx, requestStateContextKey, state))
+	for key, values := range options.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if state.cancel != nil {
+		req.AddRetryCondition(state.stopRetries)
+	}
+
+	return req
+}
+
+// stopRetries is a retry condition which ends the retries of a request. Retry conditions can not overrule
+// each other, so it cancels the context of the request, which ends the retries, and keeps the error of the attempt.
+func (s *requestState) stopRetries(_ *resty.Response, err error) bool {
+	s.mu.Lock()
+	s.retriesStopped, s.retryErr = true, err
+	s.mu.Unlock()
+	s.cancel()
+
+	return false
+}
+
+// prepareRequest is a resty middleware which applies the base URL of the request options.
+// It runs for every attempt of a request, as resty resets the URL.
+func prepareRequest(_ *resty.Client, req *resty.Request) error {
+	state, ok := req.Context().Value(requestStateContextKey).(*requestState)
+	if !ok || state.attempt == req.Attempt {
+		return nil
+	}
+	state.attempt = req.Attempt
+
+	if state.options.baseURL != "" && strings.HasPrefix(req.URL, state.g.basePath+urlSeparator) {
+		req.URL = state.options.baseURL + strings.TrimPrefix(req.URL, state.g.basePath)
+	}
+
+	return nil
+}
+
+// releaseResponse is a resty response middleware which releases the context of a request without retries
+// once its response was read. It runs before the retry conditions, so the request ends with its first attempt.
+func releaseResponse(_ *resty.Client, resp *resty.Response) error {
+	releaseRequest(resp.Request, nil)
+	return nil
+}
+
+// releaseRequest is a resty error hook which releases the context of a request without retries
+func releaseRequest(req *resty.Request, _ error) {
+	if state, ok := req.Context().Value(requestStateContextKey).(*requestState); ok && state.cancel != nil {
+		state.cancel()
+	}
+}
+
+// requestError returns the error of a request, which is the error of the last attempt if stopRetries ended the request
+func requestError(resp *resty.Response, err error) error {
+	if resp == nil || resp.Request == nil {
+		return err
+	}
+	state, ok := resp.Request.Context().Value(requestStateContextKey).(*requestState)
+	if !ok {
+		return err
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.retriesStopped && errors.Is(err, context.Canceled) {
+		return state.retryErr
+	}
+	return err
+}
@@ -0,0 +1,255 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeNodes starts a public and an internal node of Keycloak, a user is answered with the name of the node, the
+// Accept-Language header as first name and the X-Tenant header as last name. The client calls the public node and
+// retries errors.
+func fakeNodes(t *testing.T) (*fakeServer, *fakeServer, *gokeycloak.GoKeycloak) {
+	t.Helper()
+
+	handler := func(node string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/admin/realms/test/users/broken" {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			if r.URL.Path == "/admin/realms/test/users/slow" {
+				time.Sleep(100 * time.Millisecond)
+			}
+			writeJSON(w, gokeycloak.User{
+				Username:  gokeycloak.StringP(node),
+				FirstName: gokeycloak.StringP(r.Header.Get("Accept-Language")),
+				LastName:  gokeycloak.StringP(r.Header.Get("X-Tenant")),
+			})
+		}
+	}
+	public := newFakeServer(t, handler("public"))
+	internal := newFakeServer(t, handler("internal"))
+
+	client := gokeycloak.NewClient(public.URL)
+	client.RestyClient().SetRetryCount(2).SetRetryWaitTime(time.Millisecond).
+		AddRetryCondition(func(resp *resty.Response, err error) bool { return resp.StatusCode() >= 500 })
+	return public, internal, client
+}
+
+func Test_RequestOptions(t *testing.T) {
+	t.Parallel()
+
+	_, internal, client := fakeNodes(t)
+	ctx := context.Background()
+
+	user, _, err := client.V2().GetUserByID(ctx, "token", "test", "1",
+		gokeycloak.WithAcceptLanguage("de"),
+		gokeycloak.WithHeader("X-Tenant", "acme"),
+		gokeycloak.WithBaseURL(internal.URL),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "internal", gokeycloak.PString(user.Username))
+	require.Equal(t, "de", gokeycloak.PString(user.FirstName))
+	require.Equal(t, "acme", gokeycloak.PString(user.LastName))
+
+	optionsCtx, cancel := gokeycloak.WithRequestOptions(ctx, gokeycloak.WithAcceptLanguage("fr"))
+	defer cancel()
+	_, user, err = client.GetUserByID(optionsCtx, "token", "test", "1")
+	require.NoError(t, err)
+	require.Equal(t, "public", gokeycloak.PString(user.Username))
+	require.Equal(t, "fr", gokeycloak.PString(user.FirstName))
+
+	_, _, err = client.V2().GetUserByID(ctx, "token", "test", "slow", gokeycloak.WithTimeout(10*time.Millisecond))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_RequestOptions_WithoutRetries(t *testing.T) {
+	t.Parallel()
+
+	public, _, client := fakeNodes(t)
+	ctx := context.Background()
+
+	_, _, err := client.V2().GetUserByID(ctx, "token", "test", "broken")
+	require.Error(t, err)
+	require.Len(t, public.recorded(), 3)
+
+	public.reset()
+	_, resp, err := client.V2().GetUserByID(ctx, "token", "test", "broken", gokeycloak.WithoutRetries())
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Len(t, public.recorded(), 1)
+}
+
+func Test_WithOptions(t *testing.T) {
+	t.Parallel()
+
+	_, internal, client := fakeNodes(t)
+	ctx := context.Background()
+
+	// the options of a client copy apply to the calls of GoKeycloak
+	internalClient := client.WithOptions(gokeycloak.WithBaseURL(internal.URL), gokeycloak.WithHeader("X-Tenant", "acme"))
+	_, user, err := internalClient.WithOptions(gokeycloak.WithAcceptLanguage("it")).GetUserByID(ctx, "token", "test", "1")
+	require.NoError(t, err)
+	require.Equal(t, "internal", gokeycloak.PString(user.Username))
+	require.Equal(t, "it", gokeycloak.PString(user.FirstName))
+	require.Equal(t, "acme", gokeycloak.PString(user.LastName))
+	_, user, err = client.GetUserByID(ctx, "token", "test", "1")
+	require.NoError(t, err)
+	require.Equal(t, "public", gokeycloak.PString(user.Username))
+
+	internal.reset()
+	_, _, err = internalClient.WithOptions(gokeycloak.WithoutRetries()).GetUserByID(ctx, "token", "test", "broken")
+	apiErr, ok := err.(*gokeycloak.APIError)
+	require.True(t, ok, "expected an *APIError")
+	require.Equal(t, http.StatusServiceUnavailable, apiErr.Code)
+	require.Len(t, internal.recorded(), 1)
+
+	// the timeout limits the calls of the V2 surface, calls of GoKeycloak are limited by their context
+	timeoutClient := client.WithOptions(gokeycloak.WithTimeout(10 * time.Millisecond))
+	_, _, err = timeoutClient.V2().GetUserByID(ctx, "token", "test", "slow")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	_, _, err = timeoutClient.GetUserByID(ctx, "token", "test", "slow")
+	require.NoError(t, err)
+}
+
+func Test_RequestOptions_TimeoutLimitsCall(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(60 * time.Millisecond)
+		if r.Method == http.MethodGet {
+			writeJSON(w, gokeycloak.ClientPoliciesRepresentation{})
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	client := server.client()
+	policy := gokeycloak.ClientPolicyDefinition{Name: gokeycloak.StringP("policy")}
+
+	// each request of AddClientPolicy is faster than the timeout, both together are not
+	_, err := client.V2().AddClientPolicy(context.Background(), "token", "test", policy, gokeycloak.WithTimeout(100*time.Millisecond))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = client.V2().AddClientPolicy(context.Background(), "token", "test", policy, gokeycloak.WithTimeout(time.Second))
+	require.NoError(t, err)
+}
+
+func Test_RequestOptions_ReleasesRequests(t *testing.T) {
+	t.Parallel()
+
+	public, _, client := fakeNodes(t)
+
+	// requests built by the caller are released once resty sent them
+	req := client.WithOptions(gokeycloak.WithoutRetries()).GetRequestWithBearerAuth(context.Background(), "token")
+	resp, err := req.Get(public.URL + "/admin/realms/test/users/broken")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode())
+	require.ErrorIs(t, req.Context().Err(), context.Canceled)
+	require.Len(t, public.recorded(), 1)
+}
+
+func Test_RequestOptions_WithoutRetriesKeepsTransportErrors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	var attempts atomic.Int32
+	client := gokeycloak.NewClient(server.URL)
+	client.RestyClient().SetRetryCount(2).SetRetryWaitTime(time.Millisecond).
+		AddRetryCondition(func(resp *resty.Response, err error) bool { return err != nil }).
+		AddRetryHook(func(*resty.Response, error) { attempts.Add(1) })
+
+	_, _, err := client.WithOptions(gokeycloak.WithoutRetries()).GetUserByID(context.Background(), "token", "test", "1")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, context.Canceled)
+	require.Contains(t, err.Error(), "connection refused")
+	require.Equal(t, int32(1), attempts.Load(), "the retry hook runs once before the retries are ended")
+}
+
+func Test_CallHooks(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Tenant") != "acme" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	})
+
+	var before, after []string
+	var status int
+	client := gokeycloak.NewClient(server.URL,
+		gokeycloak.AddBeforeCallHook(func(ctx context.Context, call *gokeycloak.CallInfo) {
+			before = append(before, call.Operation+"@"+call.Realm)
+			call.Header.Set("X-Tenant", "acme")
+		}),
+		gokeycloak.AddAfterCallHook(func(ctx context.Context, call *gokeycloak.CallInfo, resp *gokeycloak.Response, err error) {
+			after = append(after, call.Operation+"@"+call.Realm)
+			status = resp.StatusCode
+		}),
+	)
+
+	groups, resp, err := client.V2().GetGroups(context.Background(), "token", "test", gokeycloak.GetGroupsParams{})
+	require.NoError(t, err)
+	require.Empty(t, groups)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, []string{"GetGroups@test"}, before)
+	require.Equal(t, []string{"GetGroups@test"}, after)
+	require.Equal(t, http.StatusOK, status)
+
+	// the resty client gets the middlewares once, so the hook header is sent once
+	client.SetRestyClient(client.RestyClient())
+	client.SetRestyClient(client.RestyClient())
+	before, after = nil, nil
+	_, _, err = client.V2().GetGroups(context.Background(), "token", "test", gokeycloak.GetGroupsParams{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"GetGroups@test"}, before)
+	request := server.lastRequest(t, http.MethodGet, "/admin/realms/test/groups")
+	require.Equal(t, []string{"acme"}, request.Header.Values("X-Tenant"))
+
+	// calls of GoKeycloak do not run the hooks
+	before, after = nil, nil
+	_, groups, err = client.GetGroups(context.Background(), "token", "test", gokeycloak.GetGroupsParams{})
+	require.Error(t, err)
+	require.Nil(t, groups)
+	require.Empty(t, before)
+	require.Empty(t, after)
+}
+
+func Test_CallHooks_OncePerCall(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, jsonResponses(map[string]string{
+		"GET /admin/realms/test/client-policies/policies?include-global-policies=false": `{"policies":[]}`,
+		"PUT /admin/realms/test/client-policies/policies":                               "",
+	}))
+
+	var before, after []string
+	client := gokeycloak.NewClient(server.URL,
+		gokeycloak.AddBeforeCallHook(func(ctx context.Context, call *gokeycloak.CallInfo) {
+			before = append(before, call.Operation+"@"+call.Realm)
+		}),
+		gokeycloak.AddAfterCallHook(func(ctx context.Context, call *gokeycloak.CallInfo, resp *gokeycloak.Response, err error) {
+			after = append(after, call.Operation+"@"+call.Realm)
+		}),
+	)
+
+	// AddClientPolicy sends two requests and runs the hooks once
+	_, err := client.V2().AddClientPolicy(context.Background(), "token", "test", gokeycloak.ClientPolicyDefinition{Name: gokeycloak.StringP("policy")})
+	require.NoError(t, err)
+	require.Len(t, server.recorded(), 2)
+	require.Equal(t, []string{"AddClientPolicy@test"}, before)
+	require.Equal(t, []string{"AddClientPolicy@test"}, after)
+}
@@ -0,0 +1,215 @@
+package gokeycloak
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultPageSize is the page size used by pagers if none is given. It matches Keycloak's default max.
+const DefaultPageSize = 100
+
+// ErrPagerDone is returned by Pager.Next once all pages have been read
+var ErrPagerDone = errors.New("no more pages")
+
+// ErrCollectLimitExceeded is returned by CollectAll if there are more items than the given limit
+var ErrCollectLimitExceeded = errors.New("number of items exceeds the limit")
+
+// PageFunc fetches at most max items starting at the offset first
+type PageFunc[T any] func(ctx context.Context, first, max int) ([]T, error)
+
+// Pager walks through the pages of a list endpoint until it is exhausted.
+// A Pager is not safe for concurrent use.
+type Pager[T any] struct {
+	fetch    PageFunc[T]
+	pageSize int
+	first    int
+	done     bool
+}
+
+// NewPager creates a pager which requests pages of pageSize items starting at the offset first
+func NewPager[T any](fetch PageFunc[T], first, pageSize int) *Pager[T] {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if first < 0 {
+		first = 0
+	}
+	return &Pager[T]{
+		fetch:    fetch,
+		pageSize: pageSize,
+		first:    first,
+	}
+}
+
+// Next returns the next page.
+// It returns ErrPagerDone if there are no more pages and the context error if ctx is done.
+func (p *Pager[T]) Next(ctx context.Context) ([]T, error) {
+	if p.done {
+		return nil, ErrPagerDone
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	page, err := p.fetch(ctx, p.first, p.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	p.first += len(page)
+	if len(page) < p.pageSize {
+		p.done = true
+	}
+	if len(page) == 0 {
+		return nil, ErrPagerDone
+	}
+
+	return page, nil
+}
+
+// CollectAll reads all remaining items of the pager.
+// If the pager holds more than limit items, the first limit items are returned together with ErrCollectLimitExceeded.
+func CollectAll[T any](ctx context.Context, pager *Pager[T], limit int) ([]T, error) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	var result []T
+	for {
+		page, err := pager.Next(ctx)
+		if errors.Is(err, ErrPagerDone) {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		if len(result)+len(page) > limit {
+			return append(result, page[:limit-len(result)]...), ErrCollectLimitExceeded
+		}
+		result = append(result, page...)
+	}
+}
+
+// GetUsersPager returns a pager over GetUsers.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetUsersPager(token, realm string, params GetUsersParams, pageSize int) *Pager[*User] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*User, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		_, users, err := g.GetUsers(ctx, token, realm, params)
+		return users, err
+	}, PInt(params.First), pageSize)
+}
+
+// GetGroupsPager returns a pager over GetGroups.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetGroupsPager(token, realm string, params GetGroupsParams, pageSize int) *Pager[*Group] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*Group, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		_, groups, err := g.GetGroups(ctx, token, realm, params)
+		return groups, err
+	}, PInt(params.First), pageSize)
+}
+
+// GetGroupMembersPager returns a pager over GetGroupMembers.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetGroupMembersPager(token, realm, groupID string, params GetGroupsParams, pageSize int) *Pager[*User] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*User, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		_, users, err := g.GetGroupMembers(ctx, token, realm, groupID, params)
+		return users, err
+	}, PInt(params.First), pageSize)
+}
+
+// GetClientsPager returns a pager over GetClients.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetClientsPager(token, realm string, params GetClientsParams, pageSize int) *Pager[*Client] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*Client, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		_, clients, err := g.GetClients(ctx, token, realm, params)
+		return clients, err
+	}, PInt(params.First), pageSize)
+}
+
+// GetEventsPager returns a pager over GetEvents.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetEventsPager(token, realm string, params GetEventsParams, pageSize int) *Pager[*EventRepresentation] {
+	if pageSize <= 0 {
+		pageSize = int(PInt32(params.Max))
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*EventRepresentation, error) {
+		params.First, params.Max = Int32P(int32(first)), Int32P(int32(max))
+		return g.GetEvents(ctx, token, realm, params)
+	}, int(PInt32(params.First)), pageSize)
+}
+
+// GetResourcesPager returns a pager over GetResources.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetResourcesPager(token, realm, idOfClient string, params GetResourceParams, pageSize int) *Pager[*ResourceRepresentation] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*ResourceRepresentation, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		_, resources, err := g.GetResources(ctx, token, realm, idOfClient, params)
+		return resources, err
+	}, PInt(params.First), pageSize)
+}
+
+// GetPoliciesPager returns a pager over GetPolicies.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetPoliciesPager(token, realm, idOfClient string, params GetPolicyParams, pageSize int) *Pager[*PolicyRepresentation] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*PolicyRepresentation, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		return g.GetPolicies(ctx, token, realm, idOfClient, params)
+	}, PInt(params.First), pageSize)
+}
+
+// GetPermissionsPager returns a pager over GetPermissions.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetPermissionsPager(token, realm, idOfClient string, params GetPermissionParams, pageSize int) *Pager[*PermissionRepresentation] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*PermissionRepresentation, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		return g.GetPermissions(ctx, token, realm, idOfClient, params)
+	}, PInt(params.First), pageSize)
+}
+
+// GetClientUserSessionsPager returns a pager over the user sessions of the client.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetClientUserSessionsPager(token, realm, idOfClient string, params GetSessionsParams, pageSize int) *Pager[*UserSessionRepresentation] {
+	return g.clientSessionsPager(token, realm, idOfClient, false, params, pageSize)
+}
+
+// GetClientOfflineSessionsPager returns a pager over the offline sessions of the client.
+// params.First is used as start offset, params.Max as page size if pageSize is 0.
+func (g *GoKeycloak) GetClientOfflineSessionsPager(token, realm, idOfClient string, params GetSessionsParams, pageSize int) *Pager[*UserSessionRepresentation] {
+	return g.clientSessionsPager(token, realm, idOfClient, true, params, pageSize)
+}
+
+func (g *GoKeycloak) clientSessionsPager(token, realm, idOfClient string, offline bool, params GetSessionsParams, pageSize int) *Pager[*UserSessionRepresentation] {
+	if pageSize <= 0 {
+		pageSize = PInt(params.Max)
+	}
+	return NewPager(func(ctx context.Context, first, max int) ([]*UserSessionRepresentation, error) {
+		params.First, params.Max = IntP(first), IntP(max)
+		return g.getClientSessions(ctx, token, realm, idOfClient, offline, params)
+	}, PInt(params.First), pageSize)
+}
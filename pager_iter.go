@@ -0,0 +1,53 @@
+//go:build go1.23
+
+package gokeycloak
+
+import (
+	"context"
+	"iter"
+
+	"github.com/pkg/errors"
+)
+
+// All returns an iterator over the remaining items of the pager.
+// Iteration stops after the first error, which is yielded together with the zero value of T.
+func (p *Pager[T]) All(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			page, err := p.Next(ctx)
+			if errors.Is(err, ErrPagerDone) {
+				return
+			}
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Pages returns an iterator over the remaining pages of the pager.
+// Iteration stops after the first error, which is yielded together with a nil page.
+func (p *Pager[T]) Pages(ctx context.Context) iter.Seq2[[]T, error] {
+	return func(yield func([]T, error) bool) {
+		for {
+			page, err := p.Next(ctx)
+			if errors.Is(err, ErrPagerDone) {
+				return
+			}
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(page, nil) {
+				return
+			}
+		}
+	}
+}
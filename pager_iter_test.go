@@ -0,0 +1,47 @@
+//go:build go1.23
+
+package gokeycloak_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_Pager_All(t *testing.T) {
+	t.Parallel()
+
+	var calls [][2]int
+	pager := gokeycloak.NewPager(fakePageFunc(5, &calls), 0, 2)
+
+	var items []int
+	for item, err := range pager.All(context.Background()) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if item == 2 {
+			break
+		}
+	}
+	require.Equal(t, []int{0, 1, 2}, items)
+	require.Len(t, calls, 2)
+}
+
+func Test_Pager_PagesError(t *testing.T) {
+	t.Parallel()
+
+	fetchErr := errors.New("fetch failed")
+	pager := gokeycloak.NewPager(func(ctx context.Context, first, max int) ([]int, error) {
+		return nil, fetchErr
+	}, 0, 2)
+
+	var errs []error
+	for page, err := range pager.Pages(context.Background()) {
+		require.Nil(t, page)
+		errs = append(errs, err)
+	}
+	require.Equal(t, []error{fetchErr}, errs)
+}
@@ -0,0 +1,100 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func fakePageFunc(total int, calls *[][2]int) gokeycloak.PageFunc[int] {
+	return func(ctx context.Context, first, max int) ([]int, error) {
+		*calls = append(*calls, [2]int{first, max})
+		var page []int
+		for i := first; i < total && i < first+max; i++ {
+			page = append(page, i)
+		}
+		return page, nil
+	}
+}
+
+func Test_Pager_Next(t *testing.T) {
+	t.Parallel()
+
+	var calls [][2]int
+	pager := gokeycloak.NewPager(fakePageFunc(5, &calls), 0, 2)
+	ctx := context.Background()
+
+	var items []int
+	for {
+		page, err := pager.Next(ctx)
+		if err == gokeycloak.ErrPagerDone {
+			break
+		}
+		require.NoError(t, err)
+		items = append(items, page...)
+	}
+	require.Equal(t, []int{0, 1, 2, 3, 4}, items)
+	require.Equal(t, [][2]int{{0, 2}, {2, 2}, {4, 2}}, calls)
+
+	_, err := pager.Next(ctx)
+	require.ErrorIs(t, err, gokeycloak.ErrPagerDone)
+}
+
+func Test_Pager_StopsOnContextCancel(t *testing.T) {
+	t.Parallel()
+
+	var calls [][2]int
+	pager := gokeycloak.NewPager(fakePageFunc(10, &calls), 0, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	_, err := pager.Next(ctx)
+	require.NoError(t, err)
+	cancel()
+	_, err = pager.Next(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Len(t, calls, 1)
+}
+
+func Test_CollectAll(t *testing.T) {
+	t.Parallel()
+
+	var calls [][2]int
+	items, err := gokeycloak.CollectAll(context.Background(), gokeycloak.NewPager(fakePageFunc(7, &calls), 1, 3), 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6}, items)
+
+	items, err = gokeycloak.CollectAll(context.Background(), gokeycloak.NewPager(fakePageFunc(7, &calls), 0, 3), 4)
+	require.ErrorIs(t, err, gokeycloak.ErrCollectLimitExceeded)
+	require.Equal(t, []int{0, 1, 2, 3}, items)
+}
+
+func Test_GetUsersPager(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		first, end := pageBounds(r, 3)
+		users := []gokeycloak.User{}
+		for i := first; i < end; i++ {
+			users = append(users, gokeycloak.User{ID: gokeycloak.StringP(strconv.Itoa(i))})
+		}
+		writeJSON(w, users)
+	})
+
+	pager := server.client().GetUsersPager("token", "test", gokeycloak.GetUsersParams{
+		Search: gokeycloak.StringP("john"),
+	}, 2)
+	users, err := gokeycloak.CollectAll(context.Background(), pager, 100)
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	require.Equal(t, "2", gokeycloak.PString(users[2].ID))
+
+	require.Equal(t, []string{
+		"GET /admin/realms/test/users?first=0&max=2&search=john",
+		"GET /admin/realms/test/users?first=2&max=2&search=john",
+	}, server.calls())
+}
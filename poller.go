@@ -0,0 +1,438 @@
+package gokeycloak
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PolledEvent is an event emitted by an EventPoller, either Login or Admin is set
+type PolledEvent struct {
+	Login *EventRepresentation
+	Admin *AdminEventRepresentation
+}
+
+// Time returns the time of the event in milliseconds
+func (e PolledEvent) Time() int64 {
+	if e.Admin != nil {
+		return e.Admin.Time
+	}
+	return e.Login.Time
+}
+
+// EventStreamPosition is the position of an event stream.
+// Time is the time of the latest emitted event in milliseconds,
+// Seen counts the emitted events with exactly that time by identity, as identical events may happen
+// in the same millisecond.
+type EventStreamPosition struct {
+	Time int64          `json:"time"`
+	Seen map[string]int `json:"seen,omitempty"`
+}
+
+// EventCursor is the position of an EventPoller in the login and admin event streams
+type EventCursor struct {
+	Login EventStreamPosition `json:"login"`
+	Admin EventStreamPosition `json:"admin"`
+}
+
+// EventCheckpoint persists the cursor of an EventPoller
+type EventCheckpoint interface {
+	// Load returns the saved cursor or nil, if none was saved yet
+	Load(ctx context.Context) (*EventCursor, error)
+	// Save saves the cursor
+	Save(ctx context.Context, cursor EventCursor) error
+}
+
+// MemoryCheckpoint keeps the cursor in memory
+type MemoryCheckpoint struct {
+	mu     sync.Mutex
+	cursor *EventCursor
+}
+
+// Load returns the saved cursor
+func (c *MemoryCheckpoint) Load(_ context.Context) (*EventCursor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cursor == nil {
+		return nil, nil
+	}
+	cursor := *c.cursor
+	return &cursor, nil
+}
+
+// Save saves the cursor
+func (c *MemoryCheckpoint) Save(_ context.Context, cursor EventCursor) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cursor = &cursor
+	return nil
+}
+
+// FileCheckpoint keeps the cursor in a JSON file
+type FileCheckpoint struct {
+	path string
+}
+
+// NewFileCheckpoint creates a checkpoint which stores the cursor in the file at path
+func NewFileCheckpoint(path string) *FileCheckpoint {
+	return &FileCheckpoint{path: path}
+}
+
+// Load reads the cursor from the file, a missing file is no error
+func (c *FileCheckpoint) Load(_ context.Context) (*EventCursor, error) {
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read checkpoint")
+	}
+
+	var cursor EventCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, errors.Wrap(err, "could not parse checkpoint")
+	}
+	return &cursor, nil
+}
+
+// Save writes the cursor to a temporary file and renames it, so the file is never left half written
+func (c *FileCheckpoint) Save(_ context.Context, cursor EventCursor) error {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal checkpoint")
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write checkpoint")
+	}
+	return errors.Wrap(os.Rename(tmp.Name(), c.path), "could not write checkpoint")
+}
+
+// EventPollerConfig is the configuration of an EventPoller
+type EventPollerConfig struct {
+	// Realm is the realm whose events are polled
+	Realm string
+	// Token returns the access token for the requests, e.g. Tenant.Token
+	Token func(ctx context.Context) (string, error)
+	// LoginEvents and AdminEvents select the polled event streams, at least one is required
+	LoginEvents bool
+	AdminEvents bool
+	// Interval is the time between two polls (default 10s)
+	Interval time.Duration
+	// PageSize is the number of events requested at once (default DefaultPageSize)
+	PageSize int
+	// MaxPages is the number of pages of a stream a poll keeps in memory (default 10).
+	// If more events are new, the oldest are handed over and the poller polls again right away.
+	MaxPages int
+	// Since is the time to start from if the checkpoint holds no cursor, zero means all stored events
+	Since time.Time
+	// Checkpoint persists the cursor (default MemoryCheckpoint)
+	Checkpoint EventCheckpoint
+}
+
+// EventPoller polls the login and admin events of a realm and emits every event once, oldest first.
+// The cursor is saved to the checkpoint after every handled poll, so a restarted poller resumes where it stopped.
+// Events are only fetched once the previous events were handled, so a slow consumer slows down polling.
+// Keycloak returns the newest events first, so a poll pages back to the cursor but only keeps the oldest
+// MaxPages pages of each stream, a larger backlog is handed over in several polls.
+// Keycloak returns no event IDs, so an event shifted to the next page by new events while paging may be emitted
+// twice if it has exactly the time of the last event of the previous page.
+type EventPoller struct {
+	g      *GoKeycloak
+	config EventPollerConfig
+}
+
+// NewEventPoller creates a new EventPoller
+func (g *GoKeycloak) NewEventPoller(config EventPollerConfig) (*EventPoller, error) {
+	if config.Realm == "" || config.Token == nil {
+		return nil, errors.New("event poller needs a realm and a token")
+	}
+	if !config.LoginEvents && !config.AdminEvents {
+		return nil, errors.New("event poller needs login or admin events")
+	}
+	if config.Interval <= 0 {
+		config.Interval = 10 * time.Second
+	}
+	if config.PageSize <= 0 {
+		config.PageSize = DefaultPageSize
+	}
+	if config.MaxPages <= 0 {
+		config.MaxPages = 10
+	}
+	if config.Checkpoint == nil {
+		config.Checkpoint = &MemoryCheckpoint{}
+	}
+
+	return &EventPoller{g: g, config: config}, nil
+}
+
+// Run polls until ctx is done and passes every event to handle.
+// If handle fails, the cursor of the events handled so far is saved and the error is returned.
+// Run returns nil once ctx is done.
+func (p *EventPoller) Run(ctx context.Context, handle func(ctx context.Context, event PolledEvent) error) error {
+	cursor, err := p.config.Checkpoint.Load(ctx)
+	if err != nil {
+		return err
+	}
+	if cursor == nil {
+		cursor = &EventCursor{}
+		if !p.config.Since.IsZero() {
+			cursor.Login.Time = p.config.Since.UnixMilli()
+			cursor.Admin.Time = p.config.Since.UnixMilli()
+		}
+	}
+
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		more, err := p.poll(ctx, cursor, handle)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if more {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Events runs the poller in the background and emits the events on the returned channel.
+// The channel is unbuffered, the poller blocks until each event is received.
+// Both channels are closed once the poller stopped, a failure is sent on the error channel before.
+func (p *EventPoller) Events(ctx context.Context) (<-chan PolledEvent, <-chan error) {
+	events := make(chan PolledEvent)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(events)
+
+		err := p.Run(ctx, func(ctx context.Context, event PolledEvent) error {
+			select {
+			case events <- event:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+		if err != nil {
+			errs <- err
+		}
+	}()
+
+	return events, errs
+}
+
+// poll fetches the new events of all streams, hands them over and saves the cursor.
+// It returns whether more events are new than it kept, see fetch.
+func (p *EventPoller) poll(ctx context.Context, cursor *EventCursor, handle func(ctx context.Context, event PolledEvent) error) (bool, error) {
+	token, err := p.config.Token(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not get token for event poller")
+	}
+
+	var events []PolledEvent
+	until := int64(0)
+	keep := func(streamEvents []PolledEvent, streamUntil int64) {
+		events = append(events, streamEvents...)
+		if streamUntil > 0 && (until == 0 || streamUntil < until) {
+			until = streamUntil
+		}
+	}
+	if p.config.LoginEvents {
+		loginEvents, loginUntil, err := p.fetch(ctx, cursor.Login, func(ctx context.Context, params eventPageParams) ([]PolledEvent, error) {
+			result, err := p.g.GetEvents(ctx, token, p.config.Realm, GetEventsParams{
+				DateFrom: params.dateFrom,
+				First:    Int32P(int32(params.first)),
+				Max:      Int32P(int32(params.max)),
+			})
+			polled := make([]PolledEvent, 0, len(result))
+			for _, event := range result {
+				polled = append(polled, PolledEvent{Login: event})
+			}
+			return polled, err
+		})
+		if err != nil {
+			return false, err
+		}
+		keep(loginEvents, loginUntil)
+	}
+	if p.config.AdminEvents {
+		adminEvents, adminUntil, err := p.fetch(ctx, cursor.Admin, func(ctx context.Context, params eventPageParams) ([]PolledEvent, error) {
+			_, result, err := p.g.GetAdminEvents(ctx, token, p.config.Realm, GetAdminEventsParams{
+				DateFrom: params.dateFrom,
+				First:    Int32P(int32(params.first)),
+				Max:      Int32P(int32(params.max)),
+			})
+			polled := make([]PolledEvent, 0, len(result))
+			for _, event := range result {
+				polled = append(polled, PolledEvent{Admin: event})
+			}
+			return polled, err
+		})
+		if err != nil {
+			return false, err
+		}
+		keep(adminEvents, adminUntil)
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Time() < events[j].Time() })
+	if until > 0 {
+		// the events of all streams from until on are handed over by the next poll, so they stay in order
+		events = events[:sort.Search(len(events), func(i int) bool { return events[i].Time() >= until })]
+	}
+
+	var handleErr error
+	handled := 0
+	for _, event := range events {
+		if handleErr = handle(ctx, event); handleErr != nil {
+			break
+		}
+		position := &cursor.Login
+		if event.Admin != nil {
+			position = &cursor.Admin
+		}
+		position.advance(event)
+		handled++
+	}
+	if handled > 0 {
+		if err := p.config.Checkpoint.Save(ctx, *cursor); err != nil {
+			return false, err
+		}
+	}
+
+	return until > 0, handleErr
+}
+
+type eventPageParams struct {
+	dateFrom   *string
+	first, max int
+}
+
+// fetch pages through the events of a stream, newest first, until it reaches the position.
+// It returns the unseen events, oldest first. Only the oldest MaxPages pages are kept, if newer events were
+// dropped, until is the time from which on events have to be fetched again, otherwise it is 0.
+func (p *EventPoller) fetch(ctx context.Context, position EventStreamPosition, fetchPage func(ctx context.Context, params eventPageParams) ([]PolledEvent, error)) ([]PolledEvent, int64, error) {
+	params := eventPageParams{max: p.config.PageSize}
+	if position.Time > 0 {
+		// Keycloak filters by day, start a day earlier to be safe regarding its time zone
+		params.dateFrom = StringP(time.UnixMilli(position.Time).UTC().AddDate(0, 0, -1).Format("2006-01-02"))
+	}
+
+	// seen counts the occurrences of the events emitted at position.Time, which are fetched again
+	seen := make(map[string]int, len(position.Seen))
+	for id, count := range position.Seen {
+		seen[id] = count
+	}
+
+	var pages [][]PolledEvent
+	until := int64(0)
+	pageEnd := int64(-1)
+	for {
+		page, err := fetchPage(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var unseen []PolledEvent
+		reached := false
+		for _, event := range page {
+			if event.Time() < position.Time {
+				reached = true
+				continue
+			}
+			// new events shift the pages, so events newer than the end of the previous page were returned by it.
+			// Shifted events with exactly the time of the end of the previous page cannot be told apart
+			// from identical events and are emitted again.
+			if pageEnd >= 0 && event.Time() > pageEnd {
+				continue
+			}
+			if id := event.identity(); seen[id] > 0 {
+				seen[id]--
+				continue
+			}
+			unseen = append(unseen, event)
+		}
+		if len(unseen) > 0 {
+			pages = append(pages, unseen)
+		}
+		if len(pages) > p.config.MaxPages {
+			// the pages get older, so the oldest event of the dropped page is the oldest dropped event
+			dropped := pages[0]
+			until = dropped[len(dropped)-1].Time()
+			pages = pages[1:]
+		}
+		if len(page) > 0 {
+			pageEnd = page[len(page)-1].Time()
+		}
+		if reached || len(page) < params.max {
+			break
+		}
+		params.first += len(page)
+	}
+
+	var result []PolledEvent
+	for _, page := range pages {
+		result = append(result, page...)
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Time() < result[j].Time() })
+	if until > 0 && len(result) > 0 && result[0].Time() >= until {
+		// all kept events have the time of the dropped ones, hand them over to make progress
+		until++
+	}
+	return result, until, nil
+}
+
+// identity returns a hash of the event, Keycloak does not return event IDs
+func (e PolledEvent) identity() string {
+	var data []byte
+	if e.Admin != nil {
+		data, _ = json.Marshal(e.Admin)
+	} else {
+		data, _ = json.Marshal(e.Login)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// advance moves the position to the given event
+func (s *EventStreamPosition) advance(event PolledEvent) {
+	switch {
+	case event.Time() > s.Time:
+		s.Time = event.Time()
+		s.Seen = map[string]int{event.identity(): 1}
+	case event.Time() == s.Time:
+		if s.Seen == nil {
+			s.Seen = map[string]int{}
+		}
+		s.Seen[event.identity()]++
+	}
+}
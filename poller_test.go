@@ -0,0 +1,161 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_EventPoller(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var loginEvents, adminEvents []map[string]interface{}
+	addLogin := func(time int64, user string) {
+		mu.Lock()
+		defer mu.Unlock()
+		loginEvents = append(loginEvents, map[string]interface{}{"time": time, "type": "LOGIN", "userId": user})
+	}
+	addAdmin := func(time int64, path string) {
+		mu.Lock()
+		defer mu.Unlock()
+		adminEvents = append(adminEvents, map[string]interface{}{"time": time, "operationType": "CREATE", "resourcePath": path})
+	}
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		events := loginEvents
+		if r.URL.Path == "/admin/realms/test/admin-events" {
+			events = adminEvents
+		}
+		// Keycloak returns the newest events first
+		sorted := append([]map[string]interface{}{}, events...)
+		sort.SliceStable(sorted, func(i, j int) bool { return sorted[i]["time"].(int64) > sorted[j]["time"].(int64) })
+
+		first, end := pageBounds(r, len(sorted))
+		writeJSON(w, sorted[first:end])
+	})
+
+	client := server.client()
+	checkpoint := gokeycloak.NewFileCheckpoint(filepath.Join(t.TempDir(), "cursor.json"))
+	newPoller := func() *gokeycloak.EventPoller {
+		poller, err := client.NewEventPoller(gokeycloak.EventPollerConfig{
+			Realm:       "test",
+			Token:       func(context.Context) (string, error) { return "token", nil },
+			LoginEvents: true,
+			AdminEvents: true,
+			Interval:    10 * time.Millisecond,
+			PageSize:    2,
+			Checkpoint:  checkpoint,
+		})
+		require.NoError(t, err)
+		return poller
+	}
+	// collect runs a poller until it emitted n events
+	collect := func(n int) []string {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var result []string
+		events, errs := newPoller().Events(ctx)
+		for event := range events {
+			if event.Login != nil {
+				result = append(result, "login:"+gokeycloak.PString(event.Login.UserID))
+			} else {
+				result = append(result, "admin:"+gokeycloak.PString(event.Admin.ResourcePath))
+			}
+			if len(result) == n {
+				cancel()
+			}
+		}
+		require.NoError(t, <-errs)
+		return result
+	}
+
+	addLogin(1000, "a")
+	addLogin(2000, "b")
+	addAdmin(1500, "users/a")
+	addLogin(3000, "c")
+	addLogin(3000, "d")
+	require.Equal(t, []string{"login:a", "admin:users/a", "login:b", "login:c", "login:d"}, collect(5))
+
+	addLogin(3000, "e")
+	addLogin(4000, "f")
+	addAdmin(3500, "users/f")
+	require.Equal(t, []string{"login:e", "admin:users/f", "login:f"}, collect(3))
+
+	cursor, err := checkpoint.Load(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(4000), cursor.Login.Time)
+	require.Equal(t, int64(3500), cursor.Admin.Time)
+
+	// identical events in the same millisecond are emitted each
+	addLogin(5000, "g")
+	addLogin(5000, "g")
+	require.Equal(t, []string{"login:g", "login:g"}, collect(2))
+	addLogin(5000, "g")
+	require.Equal(t, []string{"login:g"}, collect(1))
+
+	cursor, err = checkpoint.Load(context.Background())
+	require.NoError(t, err)
+	require.Len(t, cursor.Login.Seen, 1)
+	for _, count := range cursor.Login.Seen {
+		require.Equal(t, 3, count)
+	}
+}
+
+func Test_EventPoller_MaxPages(t *testing.T) {
+	t.Parallel()
+
+	// Keycloak returns the newest events first
+	var events []map[string]interface{}
+	for time := int64(7000); time >= 1000; time -= 1000 {
+		events = append(events, map[string]interface{}{"time": time, "type": "LOGIN", "userId": strconv.FormatInt(time/1000, 10)})
+	}
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		first, end := pageBounds(r, len(events))
+		writeJSON(w, events[first:end])
+	})
+
+	poller, err := server.client().NewEventPoller(gokeycloak.EventPollerConfig{
+		Realm:       "test",
+		Token:       func(context.Context) (string, error) { return "token", nil },
+		LoginEvents: true,
+		Interval:    time.Hour,
+		PageSize:    2,
+		MaxPages:    1,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var users []string
+	// polls counts the events handed over by each poll, by the number of requests sent until then
+	polls := map[int]int{}
+	err = poller.Run(ctx, func(ctx context.Context, event gokeycloak.PolledEvent) error {
+		polls[len(server.recorded())]++
+		users = append(users, gokeycloak.PString(event.Login.UserID))
+		if len(users) == len(events) {
+			cancel()
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7"}, users)
+	// a poll hands over at most one page of events
+	require.Len(t, polls, 4)
+	for _, count := range polls {
+		require.LessOrEqual(t, count, 2)
+	}
+}
@@ -1,6 +1,11 @@
 package gokeycloak
 
-import "context"
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
 
 func (g *GoKeycloak) getRealmURL(realm string, path ...string) string {
 	path = append([]string{g.basePath, g.Config.authRealms, realm}, path...)
@@ -90,3 +95,43 @@ func (g *GoKeycloak) ClearRealmCache(ctx context.Context, token, realm string) (
 
 	return resp.StatusCode(), checkForError(resp, err, errMessage)
 }
+
+// PartialExportRealm exports the realm, optionally including its clients, groups and roles.
+// Secrets are masked by Keycloak.
+func (g *GoKeycloak) PartialExportRealm(ctx context.Context, token, realm string, params PartialExportParams) (int, *RealmRepresentation, error) {
+	const errMessage = "could not export realm"
+
+	queryParams, err := GetQueryParams(params)
+	if err != nil {
+		return http.StatusBadRequest, nil, errors.Wrap(err, errMessage)
+	}
+
+	var result RealmRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParams(queryParams).
+		Post(g.getAdminRealmURL(realm, "partial-export"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// PartialImportRealm imports users, clients, groups, identity providers and roles into the realm
+func (g *GoKeycloak) PartialImportRealm(ctx context.Context, token, realm string, partialImport PartialImportRepresentation) (int, *PartialImportResult, error) {
+	const errMessage = "could not import realm"
+
+	var result PartialImportResult
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetBody(partialImport).
+		Post(g.getAdminRealmURL(realm, "partialImport"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
@@ -0,0 +1,276 @@
+package gokeycloak
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// RealmState is a typed snapshot of a realm and its sub resources.
+// It is read from Keycloak by GetRealmState or converted from a realm representation by NewRealmState.
+// A nil section is not part of the state, e.g. because the realm representation does not contain it.
+type RealmState struct {
+	Realm string
+	// Clients hold their protocol mappers
+	Clients []Client
+	// RealmRoles hold their composites
+	RealmRoles []Role
+	// ClientRoles hold their composites and are keyed by the clientId of their client
+	ClientRoles map[string][]Role
+	// Groups hold their sub groups and their role mappings
+	Groups []Group
+	// ClientScopes hold their protocol mappers
+	ClientScopes      []ClientScope
+	IdentityProviders []IdentityProviderRepresentation
+	RequiredActions   []RequiredActionProviderRepresentation
+	// AuthenticationFlows holds the top level flows which are not built in and their sub flows
+	AuthenticationFlows []AuthenticationFlowRepresentation
+	// AuthenticatorConfigs holds the configs of the executions, they are referenced by alias
+	AuthenticatorConfigs []AuthenticatorConfigRepresentation
+}
+
+// NewRealmState converts a realm representation, e.g. a realm export read by ParseRealm, into a RealmState
+func NewRealmState(realm RealmRepresentation) (*RealmState, error) {
+	const errMessage = "could not convert realm"
+
+	state := &RealmState{Realm: PString(realm.Realm)}
+	if realm.Clients != nil {
+		state.Clients = append([]Client{}, *realm.Clients...)
+	}
+	if realm.ClientScopes != nil {
+		state.ClientScopes = append([]ClientScope{}, *realm.ClientScopes...)
+	}
+	if realm.Roles != nil {
+		if realm.Roles.Realm != nil {
+			state.RealmRoles = append([]Role{}, *realm.Roles.Realm...)
+		}
+		if realm.Roles.Client != nil {
+			state.ClientRoles = make(map[string][]Role, len(*realm.Roles.Client))
+			for clientID, roles := range *realm.Roles.Client {
+				state.ClientRoles[clientID] = append([]Role{}, roles...)
+			}
+		}
+	}
+
+	sections := []struct {
+		section *[]interface{}
+		result  interface{}
+	}{
+		{realm.Groups, &state.Groups},
+		{realm.IdentityProviders, &state.IdentityProviders},
+		{realm.RequiredActions, &state.RequiredActions},
+		{realm.AuthenticationFlows, &state.AuthenticationFlows},
+		{realm.AuthenticatorConfig, &state.AuthenticatorConfigs},
+	}
+	for _, s := range sections {
+		if s.section == nil {
+			continue
+		}
+		data, err := json.Marshal(*s.section)
+		if err != nil {
+			return nil, errors.Wrap(err, errMessage)
+		}
+		if err := json.Unmarshal(data, s.result); err != nil {
+			return nil, errors.Wrap(err, errMessage)
+		}
+	}
+	flows := state.AuthenticationFlows[:0]
+	for _, flow := range state.AuthenticationFlows {
+		if !PBool(flow.BuiltIn) {
+			flows = append(flows, flow)
+		}
+	}
+	if state.AuthenticationFlows != nil {
+		state.AuthenticationFlows = flows
+	}
+
+	return state, nil
+}
+
+// GetRealmState reads a realm and its sub resources with the corresponding Get calls.
+// All sections of the returned state are set.
+func (g *GoKeycloak) GetRealmState(ctx context.Context, token, realm string) (*RealmState, error) {
+	return g.getRealmState(ctx, token, realm, false)
+}
+
+// GetFullRealm reads a realm with GetRealm and adds its sub resources like a realm export.
+// Unlike GetRealmState, the built in authentication flows are included.
+func (g *GoKeycloak) GetFullRealm(ctx context.Context, token, realm string) (*RealmRepresentation, error) {
+	_, representation, err := g.GetRealm(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	state, err := g.getRealmState(ctx, token, realm, true)
+	if err != nil {
+		return nil, err
+	}
+
+	representation.Clients = &state.Clients
+	representation.ClientScopes = &state.ClientScopes
+	representation.Roles = &RolesRepresentation{Realm: &state.RealmRoles, Client: &state.ClientRoles}
+	representation.Groups = interfaceSlice(state.Groups)
+	representation.IdentityProviders = interfaceSlice(state.IdentityProviders)
+	representation.RequiredActions = interfaceSlice(state.RequiredActions)
+	representation.AuthenticationFlows = interfaceSlice(state.AuthenticationFlows)
+	representation.AuthenticatorConfig = interfaceSlice(state.AuthenticatorConfigs)
+
+	return representation, nil
+}
+
+// interfaceSlice converts a typed slice for the untyped sections of RealmRepresentation
+func interfaceSlice(slice interface{}) *[]interface{} {
+	value := reflect.ValueOf(slice)
+	result := make([]interface{}, value.Len())
+	for i := range result {
+		result[i] = value.Index(i).Interface()
+	}
+	return &result
+}
+
+func (g *GoKeycloak) getRealmState(ctx context.Context, token, realm string, builtInFlows bool) (*RealmState, error) {
+	state := &RealmState{Realm: realm, ClientRoles: map[string][]Role{}}
+
+	clients, err := g.getAdminClients(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	clientIDs := make(map[string]string, len(clients))
+	for _, client := range clients {
+		clientIDs[PString(client.ID)] = PString(client.ClientID)
+	}
+	state.Clients = clients
+
+	_, realmRoles, err := g.GetRealmRoles(ctx, token, realm, GetRoleParams{BriefRepresentation: BoolP(false)})
+	if err != nil {
+		return nil, err
+	}
+	if state.RealmRoles, err = g.getRolesWithComposites(ctx, token, realm, realmRoles, clientIDs); err != nil {
+		return nil, err
+	}
+	for _, client := range clients {
+		_, clientRoles, err := g.GetClientRoles(ctx, token, realm, PString(client.ID), GetRoleParams{BriefRepresentation: BoolP(false)})
+		if err != nil {
+			return nil, err
+		}
+		if state.ClientRoles[PString(client.ClientID)], err = g.getRolesWithComposites(ctx, token, realm, clientRoles, clientIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	_, groups, err := g.GetGroups(ctx, token, realm, GetGroupsParams{BriefRepresentation: BoolP(false)})
+	if err != nil {
+		return nil, err
+	}
+	state.Groups = make([]Group, 0, len(groups))
+	for _, group := range groups {
+		state.Groups = append(state.Groups, *group)
+	}
+
+	_, scopes, err := g.GetClientScopes(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	state.ClientScopes = make([]ClientScope, 0, len(scopes))
+	for _, scope := range scopes {
+		state.ClientScopes = append(state.ClientScopes, *scope)
+	}
+
+	_, providers, err := g.GetIdentityProviders(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	state.IdentityProviders = make([]IdentityProviderRepresentation, 0, len(providers))
+	for _, provider := range providers {
+		state.IdentityProviders = append(state.IdentityProviders, *provider)
+	}
+
+	_, actions, err := g.GetRequiredActions(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+	state.RequiredActions = make([]RequiredActionProviderRepresentation, 0, len(actions))
+	for _, action := range actions {
+		state.RequiredActions = append(state.RequiredActions, *action)
+	}
+
+	if state.AuthenticationFlows, state.AuthenticatorConfigs, err = g.getAuthenticationFlowsWithSubFlows(ctx, token, realm, builtInFlows); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// getRolesWithComposites fills the composites of the roles, clientIDs maps the ids of the clients to their clientId
+func (g *GoKeycloak) getRolesWithComposites(ctx context.Context, token, realm string, roles []*Role, clientIDs map[string]string) ([]Role, error) {
+	result := make([]Role, 0, len(roles))
+	for _, role := range roles {
+		r := *role
+		if PBool(r.Composite) {
+			_, composites, err := g.GetCompositeRolesByRoleID(ctx, token, realm, PString(r.ID))
+			if err != nil {
+				return nil, err
+			}
+			realmComposites := []string{}
+			clientComposites := map[string][]string{}
+			for _, composite := range composites {
+				if PBool(composite.ClientRole) {
+					clientID := clientIDs[PString(composite.ContainerID)]
+					clientComposites[clientID] = append(clientComposites[clientID], PString(composite.Name))
+				} else {
+					realmComposites = append(realmComposites, PString(composite.Name))
+				}
+			}
+			r.Composites = &CompositesRepresentation{Realm: &realmComposites, Client: &clientComposites}
+		}
+		result = append(result, r)
+	}
+
+	return result, nil
+}
+
+// getAuthenticationFlowsWithSubFlows returns the top level flows and their sub flows, built in flows only if requested,
+// and the configs of their executions
+func (g *GoKeycloak) getAuthenticationFlowsWithSubFlows(ctx context.Context, token, realm string, builtIn bool) ([]AuthenticationFlowRepresentation, []AuthenticatorConfigRepresentation, error) {
+	_, flows, err := g.GetAuthenticationFlows(ctx, token, realm)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := []AuthenticationFlowRepresentation{}
+	configs := []AuthenticatorConfigRepresentation{}
+	for _, flow := range flows {
+		if PBool(flow.BuiltIn) && !builtIn {
+			continue
+		}
+		result = append(result, *flow)
+
+		_, executions, err := g.GetAuthenticationExecutions(ctx, token, realm, PString(flow.Alias))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, execution := range executions {
+			if !NilOrEmpty(execution.AuthenticationConfig) {
+				_, config, err := g.GetAuthenticatorConfig(ctx, token, realm, PString(execution.AuthenticationConfig))
+				if err != nil {
+					return nil, nil, err
+				}
+				configs = append(configs, *config)
+			}
+			if !PBool(execution.AuthenticationFlow) || NilOrEmpty(execution.FlowID) {
+				continue
+			}
+			_, subFlow, err := g.GetAuthenticationFlow(ctx, token, realm, PString(execution.FlowID))
+			if err != nil {
+				return nil, nil, err
+			}
+			result = append(result, *subFlow)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return PString(result[i].Alias) < PString(result[j].Alias) })
+	sort.SliceStable(configs, func(i, j int) bool { return PString(configs[i].Alias) < PString(configs[j].Alias) })
+
+	return result, configs, nil
+}
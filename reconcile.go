@@ -0,0 +1,1835 @@
+package gokeycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ReconcileAction is the kind of change of a plan step
+type ReconcileAction string
+
+// ReconcileAction values
+const (
+	ReconcileCreate ReconcileAction = "create"
+	ReconcileUpdate ReconcileAction = "update"
+	ReconcileDelete ReconcileAction = "delete"
+)
+
+// ReconcileResource is the type of object changed by a plan step
+type ReconcileResource string
+
+// ReconcileResource values, in the order in which they are created
+const (
+	ReconcileAuthenticationFlow ReconcileResource = "authentication-flow"
+	ReconcileClientScope        ReconcileResource = "client-scope"
+	ReconcileClientScopeMapper  ReconcileResource = "client-scope-protocol-mapper"
+	ReconcileClient             ReconcileResource = "client"
+	ReconcileClientMapper       ReconcileResource = "client-protocol-mapper"
+	ReconcileRealmRole          ReconcileResource = "realm-role"
+	ReconcileClientRole         ReconcileResource = "client-role"
+	ReconcileRoleComposite      ReconcileResource = "role-composite"
+	ReconcileGroup              ReconcileResource = "group"
+	ReconcileGroupRoleMapping   ReconcileResource = "group-role-mapping"
+	ReconcileIdentityProvider   ReconcileResource = "identity-provider"
+	ReconcileRequiredAction     ReconcileResource = "required-action"
+)
+
+// reconcileOrder is the order in which resources are created and updated, they are deleted in reverse order
+var reconcileOrder = []ReconcileResource{
+	ReconcileAuthenticationFlow,
+	ReconcileClientScope,
+	ReconcileClientScopeMapper,
+	ReconcileClient,
+	ReconcileClientMapper,
+	ReconcileRealmRole,
+	ReconcileClientRole,
+	ReconcileRoleComposite,
+	ReconcileGroup,
+	ReconcileGroupRoleMapping,
+	ReconcileIdentityProvider,
+	ReconcileRequiredAction,
+}
+
+// secretMask is the value Keycloak returns instead of a secret
+const secretMask = "**********"
+
+// Objects which Keycloak creates for every realm are never deleted
+var (
+	builtInClients         = []string{"account", "account-console", "admin-cli", "broker", "realm-management", "security-admin-console"}
+	builtInRealmRoles      = []string{"offline_access", "uma_authorization"}
+	builtInClientScopes    = []string{"acr", "address", "basic", "email", "microprofile-jwt", "offline_access", "organization", "phone", "profile", "role_list", "roles", "saml_organization", "web-origins"}
+	builtInRequiredActions = []string{"CONFIGURE_TOTP", "CONFIGURE_RECOVERY_AUTHN_CODES", "TERMS_AND_CONDITIONS", "UPDATE_PASSWORD", "UPDATE_PROFILE", "UPDATE_EMAIL", "VERIFY_EMAIL", "VERIFY_PROFILE", "delete_account", "delete_credential", "idp_link", "update_user_locale", "webauthn-register", "webauthn-register-passwordless"}
+)
+
+// ReconcileOptions are the options of PlanRealm and ReconcileRealm
+type ReconcileOptions struct {
+	// NoDelete keeps objects which exist in Keycloak but not in the desired state
+	NoDelete bool
+	// DryRun makes ReconcileRealm return the plan without applying it
+	DryRun bool
+}
+
+// PlanStep is a single change of a RealmPlan
+type PlanStep struct {
+	Action   ReconcileAction   `json:"action"`
+	Resource ReconcileResource `json:"resource"`
+	// Path identifies the changed object, e.g. clients/my-app/roles/admin
+	Path string `json:"path"`
+	// Fields are the top level fields changed by an update
+	Fields []string `json:"fields,omitempty"`
+
+	// ref is the path of the role referenced by a composite or a role mapping
+	ref   string
+	apply func(ctx context.Context, r *reconcileRun) error
+}
+
+// RealmPlan is the ordered list of changes which turn the current state of a realm into the desired state.
+// Sections missing in the desired state are not managed and never changed.
+type RealmPlan struct {
+	Realm string     `json:"realm"`
+	Steps []PlanStep `json:"steps"`
+
+	current *RealmState
+}
+
+// Empty returns true if the realm is in the desired state
+func (p *RealmPlan) Empty() bool {
+	return len(p.Steps) == 0
+}
+
+// String returns the plan as a human readable dry run output
+func (p *RealmPlan) String() string {
+	if p.Empty() {
+		return fmt.Sprintf("realm %s: no changes\n", p.Realm)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "realm %s: %d changes\n", p.Realm, len(p.Steps))
+	symbols := map[ReconcileAction]string{ReconcileCreate: "+", ReconcileUpdate: "~", ReconcileDelete: "-"}
+	for _, step := range p.Steps {
+		fmt.Fprintf(&b, "%s %s %s", symbols[step.Action], step.Resource, step.Path)
+		if len(step.Fields) > 0 {
+			fmt.Fprintf(&b, " (%s)", strings.Join(step.Fields, ", "))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// PlanRealm compares the desired state with the current state of the realm named in desired and
+// returns the steps needed to reach the desired state. Nothing is changed.
+//
+// Objects are matched by their natural keys (clientId, name, alias or group path) and updates only
+// consider the fields set in the desired state, so ids and server defaults never cause a change.
+// Read realm exports with ReadRealmFile or ParseRealm, so the clients keep their fields.
+func (g *GoKeycloak) PlanRealm(ctx context.Context, token string, desired RealmRepresentation, options ReconcileOptions) (*RealmPlan, error) {
+	if NilOrEmpty(desired.Realm) {
+		return nil, errors.New("could not plan realm: the desired realm has no name")
+	}
+	desiredState, err := NewRealmState(desired)
+	if err != nil {
+		return nil, err
+	}
+	current, err := g.GetRealmState(ctx, token, *desired.Realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return planRealm(desiredState, current, options)
+}
+
+// ApplyRealmPlan applies the steps of the plan in order and stops at the first failure
+func (g *GoKeycloak) ApplyRealmPlan(ctx context.Context, token string, plan *RealmPlan) error {
+	run := newReconcileRun(g, token, plan)
+	for _, step := range plan.Steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := step.apply(ctx, run); err != nil {
+			return errors.Wrapf(err, "could not %s %s", step.Action, step.Path)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileRealm plans the changes to reach the desired state and applies them unless options.DryRun is set.
+// The plan is returned in both cases.
+func (g *GoKeycloak) ReconcileRealm(ctx context.Context, token string, desired RealmRepresentation, options ReconcileOptions) (*RealmPlan, error) {
+	plan, err := g.PlanRealm(ctx, token, desired, options)
+	if err != nil {
+		return nil, err
+	}
+	if options.DryRun {
+		return plan, nil
+	}
+
+	return plan, g.ApplyRealmPlan(ctx, token, plan)
+}
+
+// ----------
+// Planning
+// ----------
+
+type planner struct {
+	desired *RealmState
+	current *RealmState
+	options ReconcileOptions
+	steps   []PlanStep
+}
+
+func planRealm(desired, current *RealmState, options ReconcileOptions) (*RealmPlan, error) {
+	p := &planner{desired: desired, current: current, options: options}
+	p.planAuthenticationFlows()
+	p.planClientScopes()
+	if err := p.planClients(); err != nil {
+		return nil, err
+	}
+	p.planRoles()
+	p.planGroups()
+	p.planIdentityProviders()
+	p.planRequiredActions()
+
+	return &RealmPlan{Realm: current.Realm, Steps: p.orderedSteps(), current: current}, nil
+}
+
+func (p *planner) add(step PlanStep) {
+	if step.Action == ReconcileDelete && p.options.NoDelete {
+		return
+	}
+	p.steps = append(p.steps, step)
+}
+
+// orderedSteps sorts creates and updates by resource and puts the deletes in reverse order after them.
+// Deletes of objects which are removed with a deleted container or role are dropped.
+func (p *planner) orderedSteps() []PlanStep {
+	rank := make(map[ReconcileResource]int, len(reconcileOrder))
+	for i, resource := range reconcileOrder {
+		rank[resource] = i
+	}
+	order := func(step PlanStep) int {
+		if step.Action == ReconcileDelete {
+			return 2*len(reconcileOrder) - rank[step.Resource]
+		}
+		return rank[step.Resource]
+	}
+	sort.SliceStable(p.steps, func(i, j int) bool { return order(p.steps[i]) < order(p.steps[j]) })
+
+	deleted := map[string]bool{}
+	for _, step := range p.steps {
+		if step.Action == ReconcileDelete {
+			deleted[step.Path] = true
+		}
+	}
+	removed := func(step PlanStep) bool {
+		if step.Action != ReconcileDelete {
+			return false
+		}
+		if deleted[step.ref] {
+			return true
+		}
+		for path := step.Path; strings.Contains(path, "/"); {
+			path = path[:strings.LastIndex(path, "/")]
+			if deleted[path] {
+				return true
+			}
+		}
+		return false
+	}
+
+	steps := []PlanStep{}
+	for _, step := range p.steps {
+		if !removed(step) {
+			steps = append(steps, step)
+		}
+	}
+
+	return steps
+}
+
+// diffKeys returns the sorted keys only in desired, in both and only in current
+func diffKeys(desired, current []string) (create, both, remove []string) {
+	inCurrent := make(map[string]bool, len(current))
+	for _, key := range current {
+		inCurrent[key] = true
+	}
+	inDesired := make(map[string]bool, len(desired))
+	for _, key := range desired {
+		inDesired[key] = true
+		if inCurrent[key] {
+			both = append(both, key)
+		} else {
+			create = append(create, key)
+		}
+	}
+	for _, key := range current {
+		if !inDesired[key] {
+			remove = append(remove, key)
+		}
+	}
+	sort.Strings(create)
+	sort.Strings(both)
+	sort.Strings(remove)
+
+	return create, both, remove
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func rolePath(clientID, name string) string {
+	if clientID == "" {
+		return "roles/" + name
+	}
+	return "clients/" + clientID + "/roles/" + name
+}
+
+func (p *planner) planAuthenticationFlows() {
+	if p.desired.AuthenticationFlows == nil {
+		return
+	}
+	desired := flowsByAlias(p.desired.AuthenticationFlows)
+	current := flowsByAlias(p.current.AuthenticationFlows)
+	// configs are only compared if the desired state contains them
+	var desiredConfigs, currentConfigs map[string]AuthenticatorConfigRepresentation
+	if p.desired.AuthenticatorConfigs != nil {
+		desiredConfigs, currentConfigs = configsByAlias(p.desired.AuthenticatorConfigs), configsByAlias(p.current.AuthenticatorConfigs)
+	}
+	create, both, remove := diffKeys(topLevelFlows(p.desired.AuthenticationFlows), topLevelFlows(p.current.AuthenticationFlows))
+
+	for _, alias := range create {
+		flow := desired[alias]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileAuthenticationFlow, Path: "authentication-flows/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				return r.createFlow(ctx, flow, desired, desiredConfigs)
+			}})
+	}
+	for _, alias := range both {
+		flow, currentFlow := desired[alias], current[alias]
+		fields := changedFields(flowTree(flow, desired, desiredConfigs, 0), flowTree(currentFlow, current, currentConfigs, 0))
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileAuthenticationFlow, Path: "authentication-flows/" + alias, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var updated AuthenticationFlowRepresentation
+				if err := mergeJSON(currentFlow, flow, &updated); err != nil {
+					return err
+				}
+				updated.ID, updated.AuthenticationExecutions = currentFlow.ID, nil
+				if _, _, err := r.g.UpdateAuthenticationFlow(ctx, r.token, r.realm, updated, PString(currentFlow.ID)); err != nil {
+					return err
+				}
+				if !containsString(fields, "authenticationExecutions") {
+					return nil
+				}
+				return r.updateExecutions(ctx, PString(currentFlow.ID), flow, desired, current, desiredConfigs)
+			}})
+	}
+	for _, alias := range remove {
+		id := PString(current[alias].ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileAuthenticationFlow, Path: "authentication-flows/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteAuthenticationFlow(ctx, r.token, r.realm, id)
+				return err
+			}})
+	}
+}
+
+func flowsByAlias(flows []AuthenticationFlowRepresentation) map[string]AuthenticationFlowRepresentation {
+	result := make(map[string]AuthenticationFlowRepresentation, len(flows))
+	for _, flow := range flows {
+		result[PString(flow.Alias)] = flow
+	}
+	return result
+}
+
+func topLevelFlows(flows []AuthenticationFlowRepresentation) []string {
+	var aliases []string
+	for _, flow := range flows {
+		if PBool(flow.TopLevel) {
+			aliases = append(aliases, PString(flow.Alias))
+		}
+	}
+	return aliases
+}
+
+func isFlowExecution(execution AuthenticationExecutionRepresentation) bool {
+	return PBool(execution.AuthenticatorFlow) || PBool(execution.AutheticatorFlow)
+}
+
+// sortedExecutions returns the executions of the flow ordered by priority
+func sortedExecutions(flow AuthenticationFlowRepresentation) []AuthenticationExecutionRepresentation {
+	if flow.AuthenticationExecutions == nil {
+		return nil
+	}
+	executions := append([]AuthenticationExecutionRepresentation{}, *flow.AuthenticationExecutions...)
+	sort.SliceStable(executions, func(i, j int) bool { return PInt(executions[i].Priority) < PInt(executions[j].Priority) })
+	return executions
+}
+
+// maxFlowDepth protects flowTree against sub flows referencing each other
+const maxFlowDepth = 16
+
+// flowTree returns the flow with its sub flows nested into the executions, so flows can be compared independently of ids.
+// The configs of the executions are only part of the tree if configs is set.
+func flowTree(flow AuthenticationFlowRepresentation, flows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation, depth int) map[string]interface{} {
+	tree := map[string]interface{}{}
+	if flow.Description != nil {
+		tree["description"] = *flow.Description
+	}
+	if flow.ProviderID != nil {
+		tree["providerId"] = *flow.ProviderID
+	}
+	if flow.AuthenticationExecutions == nil || depth > maxFlowDepth {
+		return tree
+	}
+
+	executions := []interface{}{}
+	for _, execution := range sortedExecutions(flow) {
+		node := map[string]interface{}{}
+		if execution.Requirement != nil {
+			node["requirement"] = *execution.Requirement
+		}
+		if isFlowExecution(execution) {
+			node["flowAlias"] = PString(execution.FlowAlias)
+			node["flow"] = flowTree(flows[PString(execution.FlowAlias)], flows, configs, depth+1)
+		} else {
+			node["authenticator"] = PString(execution.Authenticator)
+		}
+		if configs != nil {
+			node["authenticatorConfig"] = PString(execution.AuthenticatorConfig)
+			if config, ok := configs[PString(execution.AuthenticatorConfig)]; ok {
+				node["config"] = configValues(config)
+			}
+		}
+		executions = append(executions, node)
+	}
+	tree["authenticationExecutions"] = executions
+
+	return tree
+}
+
+func configsByAlias(configs []AuthenticatorConfigRepresentation) map[string]AuthenticatorConfigRepresentation {
+	result := make(map[string]AuthenticatorConfigRepresentation, len(configs))
+	for _, config := range configs {
+		result[PString(config.Alias)] = config
+	}
+	return result
+}
+
+// configValues returns the values of a config as a string, so removed values are a change as well
+func configValues(config AuthenticatorConfigRepresentation) string {
+	values := map[string]string{}
+	if config.Config != nil {
+		values = *config.Config
+	}
+	return canonicalJSON(values)
+}
+
+// flowNodes flattens a flow of a realm representation like FlowBuilder.desiredNodes.
+// The configs of the executions are only managed if configs is set.
+func flowNodes(flow AuthenticationFlowRepresentation, flows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation, path string, depth int, result []flowNode) []flowNode {
+	if depth > maxFlowDepth {
+		return result
+	}
+	for _, execution := range sortedExecutions(flow) {
+		node := flowNode{parent: PString(flow.Alias), level: depth, requirement: PString(execution.Requirement)}
+		if isFlowExecution(execution) {
+			node.key, node.subFlow = PString(execution.FlowAlias), true
+		} else {
+			node.key = PString(execution.Authenticator)
+		}
+		node.path = path + "/" + node.key
+		if config, ok := configs[PString(execution.AuthenticatorConfig)]; ok {
+			node.config = &AuthenticatorConfigRepresentation{Alias: config.Alias, Config: config.Config}
+		} else {
+			node.keepConfig = configs == nil || execution.AuthenticatorConfig != nil
+		}
+		result = append(result, node)
+		if node.subFlow {
+			result = flowNodes(flows[node.key], flows, configs, node.path, depth+1, result)
+		}
+	}
+	return result
+}
+
+func flowProviderID(flow AuthenticationFlowRepresentation) string {
+	if flow.ProviderID == nil {
+		return "basic-flow"
+	}
+	return *flow.ProviderID
+}
+
+func (p *planner) planClientScopes() {
+	if p.desired.ClientScopes == nil {
+		return
+	}
+	desired := map[string]ClientScope{}
+	current := map[string]ClientScope{}
+	var desiredNames, currentNames []string
+	for _, scope := range p.desired.ClientScopes {
+		desired[PString(scope.Name)] = scope
+		desiredNames = append(desiredNames, PString(scope.Name))
+	}
+	for _, scope := range p.current.ClientScopes {
+		current[PString(scope.Name)] = scope
+		currentNames = append(currentNames, PString(scope.Name))
+	}
+	create, both, remove := diffKeys(desiredNames, currentNames)
+
+	for _, name := range create {
+		scope := desired[name]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileClientScope, Path: "client-scopes/" + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				scope.ID, scope.ProtocolMappers = nil, nil
+				_, id, err := r.g.CreateClientScope(ctx, r.token, r.realm, scope)
+				r.scopes[name] = id
+				return err
+			}})
+	}
+	for _, name := range both {
+		scope, currentScope := desired[name], current[name]
+		fields := changedFields(scope, currentScope, "id", "protocolMappers")
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileClientScope, Path: "client-scopes/" + name, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var updated ClientScope
+				if err := mergeJSON(currentScope, scope, &updated); err != nil {
+					return err
+				}
+				updated.ID, updated.ProtocolMappers = currentScope.ID, nil
+				_, err := r.g.UpdateClientScope(ctx, r.token, r.realm, updated)
+				return err
+			}})
+	}
+	for _, name := range remove {
+		if containsString(builtInClientScopes, name) {
+			continue
+		}
+		id := PString(current[name].ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileClientScope, Path: "client-scopes/" + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteClientScope(ctx, r.token, r.realm, id)
+				return err
+			}})
+	}
+
+	for _, name := range desiredNames {
+		scope := desired[name]
+		if scope.ProtocolMappers == nil {
+			continue
+		}
+		var currentMappers []ProtocolMappers
+		if currentScope, ok := current[name]; ok && currentScope.ProtocolMappers != nil {
+			currentMappers = *currentScope.ProtocolMappers
+		}
+		p.planClientScopeMappers(name, *scope.ProtocolMappers, currentMappers)
+	}
+}
+
+func (p *planner) planClientScopeMappers(scopeName string, desiredMappers, currentMappers []ProtocolMappers) {
+	desired := map[string]ProtocolMappers{}
+	current := map[string]ProtocolMappers{}
+	var desiredNames, currentNames []string
+	for _, mapper := range desiredMappers {
+		desired[PString(mapper.Name)] = mapper
+		desiredNames = append(desiredNames, PString(mapper.Name))
+	}
+	for _, mapper := range currentMappers {
+		current[PString(mapper.Name)] = mapper
+		currentNames = append(currentNames, PString(mapper.Name))
+	}
+	create, both, remove := diffKeys(desiredNames, currentNames)
+	path := "client-scopes/" + scopeName + "/protocol-mappers/"
+
+	for _, name := range create {
+		mapper := desired[name]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileClientScopeMapper, Path: path + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				scopeID, err := r.scopeID(ctx, scopeName)
+				if err != nil {
+					return err
+				}
+				mapper.ID = nil
+				_, _, err = r.g.CreateClientScopeProtocolMapper(ctx, r.token, r.realm, scopeID, mapper)
+				return err
+			}})
+	}
+	for _, name := range both {
+		mapper, currentMapper := desired[name], current[name]
+		fields := changedFields(mapper, currentMapper, "id")
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileClientScopeMapper, Path: path + name, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				scopeID, err := r.scopeID(ctx, scopeName)
+				if err != nil {
+					return err
+				}
+				var updated ProtocolMappers
+				if err := mergeJSON(currentMapper, mapper, &updated); err != nil {
+					return err
+				}
+				updated.ID = currentMapper.ID
+				_, err = r.g.UpdateClientScopeProtocolMapper(ctx, r.token, r.realm, scopeID, updated)
+				return err
+			}})
+	}
+	for _, name := range remove {
+		id := PString(current[name].ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileClientScopeMapper, Path: path + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				scopeID, err := r.scopeID(ctx, scopeName)
+				if err != nil {
+					return err
+				}
+				_, err = r.g.DeleteClientScopeProtocolMapper(ctx, r.token, r.realm, scopeID, id)
+				return err
+			}})
+	}
+}
+
+func (p *planner) planClients() error {
+	if p.desired.Clients == nil {
+		return nil
+	}
+	desired := map[string]Client{}
+	current := map[string]Client{}
+	var desiredIDs, currentIDs []string
+	for _, client := range p.desired.Clients {
+		desired[PString(client.ClientID)] = client
+		desiredIDs = append(desiredIDs, PString(client.ClientID))
+	}
+	for _, client := range p.current.Clients {
+		current[PString(client.ClientID)] = client
+		currentIDs = append(currentIDs, PString(client.ClientID))
+	}
+	create, both, remove := diffKeys(desiredIDs, currentIDs)
+
+	for _, clientID := range create {
+		client := desired[clientID]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileClient, Path: "clients/" + clientID,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				client.ID, client.ProtocolMappers = nil, nil
+				id, err := r.g.createAdminClient(ctx, r.token, r.realm, client)
+				r.clients[clientID] = id
+				return err
+			}})
+	}
+	for _, clientID := range both {
+		client, currentClient := desired[clientID], current[clientID]
+		client.ProtocolMappers, currentClient.ProtocolMappers = nil, nil
+		desiredFields, err := client.adminRepresentation()
+		if err != nil {
+			return errors.Wrap(err, "could not plan clients")
+		}
+		currentFields, err := currentClient.adminRepresentation()
+		if err != nil {
+			return errors.Wrap(err, "could not plan clients")
+		}
+		fields := changedFields(desiredFields, currentFields, "id")
+		if len(fields) == 0 {
+			continue
+		}
+		id := PString(currentClient.ID)
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileClient, Path: "clients/" + clientID, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				updated := map[string]interface{}{}
+				if err := mergeJSON(currentFields, desiredFields, &updated); err != nil {
+					return err
+				}
+				updated["id"] = id
+				return r.g.updateAdminClient(ctx, r.token, r.realm, id, updated)
+			}})
+	}
+	for _, clientID := range remove {
+		if containsString(builtInClients, clientID) {
+			continue
+		}
+		id := PString(current[clientID].ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileClient, Path: "clients/" + clientID,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteClient(ctx, r.token, r.realm, id)
+				return err
+			}})
+	}
+
+	for _, clientID := range desiredIDs {
+		client := desired[clientID]
+		if client.ProtocolMappers == nil {
+			continue
+		}
+		var currentMappers []ProtocolMapperRepresentation
+		if currentClient, ok := current[clientID]; ok && currentClient.ProtocolMappers != nil {
+			currentMappers = *currentClient.ProtocolMappers
+		}
+		if err := p.planClientMappers(clientID, *client.ProtocolMappers, currentMappers); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *planner) planClientMappers(clientID string, desiredMappers, currentMappers []ProtocolMapperRepresentation) error {
+	desired := map[string]ProtocolMapperRepresentation{}
+	current := map[string]ProtocolMapperRepresentation{}
+	var desiredNames, currentNames []string
+	for _, mapper := range desiredMappers {
+		desired[PString(mapper.Name)] = mapper
+		desiredNames = append(desiredNames, PString(mapper.Name))
+	}
+	for _, mapper := range currentMappers {
+		current[PString(mapper.Name)] = mapper
+		currentNames = append(currentNames, PString(mapper.Name))
+	}
+	create, both, remove := diffKeys(desiredNames, currentNames)
+	path := "clients/" + clientID + "/protocol-mappers/"
+
+	for _, name := range create {
+		mapper := desired[name]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileClientMapper, Path: path + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				idOfClient, err := r.clientID(ctx, clientID)
+				if err != nil {
+					return err
+				}
+				mapper.ID = nil
+				representation, err := mapper.adminRepresentation()
+				if err != nil {
+					return err
+				}
+				return r.g.createAdminClientProtocolMapper(ctx, r.token, r.realm, idOfClient, representation)
+			}})
+	}
+	for _, name := range both {
+		mapper, currentMapper := desired[name], current[name]
+		desiredFields, err := mapper.adminRepresentation()
+		if err != nil {
+			return errors.Wrap(err, "could not plan client protocol mappers")
+		}
+		currentFields, err := currentMapper.adminRepresentation()
+		if err != nil {
+			return errors.Wrap(err, "could not plan client protocol mappers")
+		}
+		fields := changedFields(desiredFields, currentFields, "id")
+		if len(fields) == 0 {
+			continue
+		}
+		id := PString(currentMapper.ID)
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileClientMapper, Path: path + name, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				idOfClient, err := r.clientID(ctx, clientID)
+				if err != nil {
+					return err
+				}
+				updated := map[string]interface{}{}
+				if err := mergeJSON(currentFields, desiredFields, &updated); err != nil {
+					return err
+				}
+				updated["id"] = id
+				return r.g.updateAdminClientProtocolMapper(ctx, r.token, r.realm, idOfClient, id, updated)
+			}})
+	}
+	for _, name := range remove {
+		id := PString(current[name].ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileClientMapper, Path: path + name,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				idOfClient, err := r.clientID(ctx, clientID)
+				if err != nil {
+					return err
+				}
+				_, err = r.g.DeleteClientProtocolMapper(ctx, r.token, r.realm, idOfClient, id)
+				return err
+			}})
+	}
+
+	return nil
+}
+
+// roleFields are the fields of roles which are managed by separate steps or set by Keycloak
+var roleFields = []string{"id", "containerId", "composite", "composites", "clientRole"}
+
+func (p *planner) planRoles() {
+	if p.desired.RealmRoles != nil {
+		p.planRoleList("", p.desired.RealmRoles, p.current.RealmRoles)
+	}
+	if p.desired.ClientRoles == nil {
+		return
+	}
+	clientIDs := make([]string, 0, len(p.desired.ClientRoles))
+	for clientID := range p.desired.ClientRoles {
+		clientIDs = append(clientIDs, clientID)
+	}
+	sort.Strings(clientIDs)
+	for _, clientID := range clientIDs {
+		p.planRoleList(clientID, p.desired.ClientRoles[clientID], p.current.ClientRoles[clientID])
+	}
+}
+
+// planRoleList plans the realm roles if clientID is empty and the roles of the client otherwise
+func (p *planner) planRoleList(clientID string, desiredRoles, currentRoles []Role) {
+	desired := map[string]Role{}
+	current := map[string]Role{}
+	var desiredNames, currentNames []string
+	for _, role := range desiredRoles {
+		desired[PString(role.Name)] = role
+		desiredNames = append(desiredNames, PString(role.Name))
+	}
+	for _, role := range currentRoles {
+		current[PString(role.Name)] = role
+		currentNames = append(currentNames, PString(role.Name))
+	}
+	create, both, remove := diffKeys(desiredNames, currentNames)
+	resource := ReconcileRealmRole
+	if clientID != "" {
+		resource = ReconcileClientRole
+	}
+
+	for _, name := range create {
+		role := Role{Name: desired[name].Name, Description: desired[name].Description, Attributes: desired[name].Attributes}
+		p.add(PlanStep{Action: ReconcileCreate, Resource: resource, Path: rolePath(clientID, name),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var id string
+				var err error
+				if clientID == "" {
+					_, id, err = r.g.CreateRealmRole(ctx, r.token, r.realm, role)
+				} else {
+					var idOfClient string
+					if idOfClient, err = r.clientID(ctx, clientID); err != nil {
+						return err
+					}
+					_, id, err = r.g.CreateClientRole(ctx, r.token, r.realm, idOfClient, role)
+				}
+				r.roles[rolePath(clientID, name)] = Role{ID: StringP(id), Name: StringP(name)}
+				return err
+			}})
+	}
+	for _, name := range both {
+		role, currentRole := desired[name], current[name]
+		fields := changedFields(role, currentRole, roleFields...)
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: resource, Path: rolePath(clientID, name), Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var updated Role
+				if err := mergeJSON(currentRole, role, &updated); err != nil {
+					return err
+				}
+				updated.ID, updated.Composite, updated.Composites = currentRole.ID, nil, nil
+				if clientID == "" {
+					_, err := r.g.UpdateRealmRole(ctx, r.token, r.realm, name, updated)
+					return err
+				}
+				idOfClient, err := r.clientID(ctx, clientID)
+				if err != nil {
+					return err
+				}
+				_, err = r.g.UpdateRole(ctx, r.token, r.realm, idOfClient, updated)
+				return err
+			}})
+	}
+	for _, name := range remove {
+		if clientID == "" && (containsString(builtInRealmRoles, name) || name == "default-roles-"+p.current.Realm) {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileDelete, Resource: resource, Path: rolePath(clientID, name),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				if clientID == "" {
+					_, err := r.g.DeleteRealmRole(ctx, r.token, r.realm, name)
+					return err
+				}
+				idOfClient, err := r.clientID(ctx, clientID)
+				if err != nil {
+					return err
+				}
+				_, err = r.g.DeleteClientRole(ctx, r.token, r.realm, idOfClient, name)
+				return err
+			}})
+	}
+
+	for _, name := range desiredNames {
+		if desired[name].Composites == nil {
+			continue
+		}
+		p.planRoleComposites(clientID, name, desired[name].Composites, current[name].Composites)
+	}
+}
+
+// roleRef is a realm role if clientID is empty and a client role otherwise
+type roleRef struct {
+	clientID string
+	name     string
+}
+
+func (ref roleRef) path() string {
+	return rolePath(ref.clientID, ref.name)
+}
+
+// roleRefs returns the roles of realm and client role name lists, sorted by path
+func roleRefs(realmRoles *[]string, clientRoles *map[string][]string) []roleRef {
+	var refs []roleRef
+	if realmRoles != nil {
+		for _, name := range *realmRoles {
+			refs = append(refs, roleRef{name: name})
+		}
+	}
+	if clientRoles != nil {
+		for clientID, names := range *clientRoles {
+			for _, name := range names {
+				refs = append(refs, roleRef{clientID: clientID, name: name})
+			}
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool { return refs[i].path() < refs[j].path() })
+	return refs
+}
+
+// diffRoleRefs returns the roles only in desired and only in current
+func diffRoleRefs(desired, current []roleRef) (add, remove []roleRef) {
+	byPath := map[string]roleRef{}
+	var desiredPaths, currentPaths []string
+	for _, ref := range desired {
+		byPath[ref.path()] = ref
+		desiredPaths = append(desiredPaths, ref.path())
+	}
+	for _, ref := range current {
+		byPath[ref.path()] = ref
+		currentPaths = append(currentPaths, ref.path())
+	}
+	create, _, removed := diffKeys(desiredPaths, currentPaths)
+	for _, path := range create {
+		add = append(add, byPath[path])
+	}
+	for _, path := range removed {
+		remove = append(remove, byPath[path])
+	}
+	return add, remove
+}
+
+func (p *planner) planRoleComposites(clientID, name string, desired, current *CompositesRepresentation) {
+	var desiredRefs, currentRefs []roleRef
+	if desired != nil {
+		desiredRefs = roleRefs(desired.Realm, desired.Client)
+	}
+	if current != nil {
+		currentRefs = roleRefs(current.Realm, current.Client)
+	}
+	add, remove := diffRoleRefs(desiredRefs, currentRefs)
+	owner := roleRef{clientID: clientID, name: name}
+
+	changeComposite := func(ctx context.Context, r *reconcileRun, ref roleRef, remove bool) error {
+		composite, err := r.role(ctx, ref)
+		if err != nil {
+			return err
+		}
+		roles := []Role{composite}
+		if clientID == "" {
+			if remove {
+				_, err = r.g.DeleteRealmRoleComposite(ctx, r.token, r.realm, name, roles)
+			} else {
+				_, err = r.g.AddRealmRoleComposite(ctx, r.token, r.realm, name, roles)
+			}
+			return err
+		}
+		role, err := r.role(ctx, owner)
+		if err != nil {
+			return err
+		}
+		if remove {
+			_, err = r.g.DeleteClientRoleComposite(ctx, r.token, r.realm, PString(role.ID), roles)
+		} else {
+			_, err = r.g.AddClientRoleComposite(ctx, r.token, r.realm, PString(role.ID), roles)
+		}
+		return err
+	}
+
+	for _, ref := range add {
+		ref := ref
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileRoleComposite, Path: owner.path() + "/composites/" + ref.path(), ref: ref.path(),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				return changeComposite(ctx, r, ref, false)
+			}})
+	}
+	for _, ref := range remove {
+		ref := ref
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileRoleComposite, Path: owner.path() + "/composites/" + ref.path(), ref: ref.path(),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				return changeComposite(ctx, r, ref, true)
+			}})
+	}
+}
+
+// groupNode is a group with its path and the path of its parent
+type groupNode struct {
+	path   string
+	parent string
+	group  Group
+}
+
+// flattenGroups returns the groups and their sub groups, parents first
+func flattenGroups(groups []Group, parent string) []groupNode {
+	var nodes []groupNode
+	for _, group := range groups {
+		path := parent + "/" + PString(group.Name)
+		nodes = append(nodes, groupNode{path: path, parent: parent, group: group})
+		if group.SubGroups != nil {
+			nodes = append(nodes, flattenGroups(*group.SubGroups, path)...)
+		}
+	}
+	return nodes
+}
+
+// groupFields are the fields of groups which are managed by separate steps or set by Keycloak
+var groupFields = []string{"id", "path", "subGroups", "subGroupCount", "realmRoles", "clientRoles", "access"}
+
+func (p *planner) planGroups() {
+	if p.desired.Groups == nil {
+		return
+	}
+	desiredNodes := flattenGroups(p.desired.Groups, "")
+	currentNodes := flattenGroups(p.current.Groups, "")
+	desired := map[string]groupNode{}
+	current := map[string]groupNode{}
+	var currentPaths []string
+	for _, node := range desiredNodes {
+		desired[node.path] = node
+	}
+	for _, node := range currentNodes {
+		current[node.path] = node
+		// sub groups are only managed if the desired parent lists its sub groups
+		if parent, ok := desired[node.parent]; node.parent == "" || !ok || parent.group.SubGroups != nil {
+			currentPaths = append(currentPaths, node.path)
+		}
+	}
+
+	// desired groups are created parents first
+	for _, node := range desiredNodes {
+		node := node
+		path := strings.TrimPrefix(node.path, "/")
+		currentNode, exists := current[node.path]
+		switch {
+		case !exists:
+			p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileGroup, Path: "groups/" + path,
+				apply: func(ctx context.Context, r *reconcileRun) error {
+					group := Group{Name: node.group.Name, Attributes: node.group.Attributes}
+					var id string
+					var err error
+					if node.parent == "" {
+						_, id, err = r.g.CreateGroup(ctx, r.token, r.realm, group)
+					} else {
+						var parentID string
+						if parentID, err = r.groupID(ctx, node.parent); err != nil {
+							return err
+						}
+						_, id, err = r.g.CreateChildGroup(ctx, r.token, r.realm, parentID, group)
+					}
+					r.groups[node.path] = id
+					return err
+				}})
+		default:
+			fields := changedFields(node.group, currentNode.group, groupFields...)
+			if len(fields) == 0 {
+				break
+			}
+			p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileGroup, Path: "groups/" + path, Fields: fields,
+				apply: func(ctx context.Context, r *reconcileRun) error {
+					var updated Group
+					if err := mergeJSON(currentNode.group, node.group, &updated); err != nil {
+						return err
+					}
+					_, err := r.g.UpdateGroup(ctx, r.token, r.realm, Group{ID: currentNode.group.ID, Name: updated.Name, Attributes: updated.Attributes})
+					return err
+				}})
+		}
+
+		var desiredRefs, currentRefs []roleRef
+		if node.group.RealmRoles != nil {
+			desiredRefs = roleRefs(node.group.RealmRoles, nil)
+			currentRefs = roleRefs(currentNode.group.RealmRoles, nil)
+		}
+		if node.group.ClientRoles != nil {
+			desiredRefs = append(desiredRefs, roleRefs(nil, node.group.ClientRoles)...)
+			currentRefs = append(currentRefs, roleRefs(nil, currentNode.group.ClientRoles)...)
+		}
+		p.planGroupRoleMappings(node.path, desiredRefs, currentRefs)
+	}
+
+	_, _, remove := diffKeys(nil, currentPaths)
+	for _, path := range remove {
+		if _, ok := desired[path]; ok {
+			continue
+		}
+		id := PString(current[path].group.ID)
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileGroup, Path: "groups/" + strings.TrimPrefix(path, "/"),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteGroup(ctx, r.token, r.realm, id)
+				return err
+			}})
+	}
+}
+
+func (p *planner) planGroupRoleMappings(groupPath string, desired, current []roleRef) {
+	add, remove := diffRoleRefs(desired, current)
+
+	changeMapping := func(ctx context.Context, r *reconcileRun, ref roleRef, remove bool) error {
+		groupID, err := r.groupID(ctx, groupPath)
+		if err != nil {
+			return err
+		}
+		role, err := r.role(ctx, ref)
+		if err != nil {
+			return err
+		}
+		roles := []Role{role}
+		if ref.clientID == "" {
+			if remove {
+				_, err = r.g.DeleteRealmRoleFromGroup(ctx, r.token, r.realm, groupID, roles)
+			} else {
+				_, err = r.g.AddRealmRoleToGroup(ctx, r.token, r.realm, groupID, roles)
+			}
+			return err
+		}
+		idOfClient, err := r.clientID(ctx, ref.clientID)
+		if err != nil {
+			return err
+		}
+		if remove {
+			_, err = r.g.DeleteClientRoleFromGroup(ctx, r.token, r.realm, idOfClient, groupID, roles)
+		} else {
+			_, err = r.g.AddClientRolesToGroup(ctx, r.token, r.realm, idOfClient, groupID, roles)
+		}
+		return err
+	}
+
+	path := "groups/" + strings.TrimPrefix(groupPath, "/") + "/role-mappings/"
+	for _, ref := range add {
+		ref := ref
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileGroupRoleMapping, Path: path + ref.path(), ref: ref.path(),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				return changeMapping(ctx, r, ref, false)
+			}})
+	}
+	for _, ref := range remove {
+		ref := ref
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileGroupRoleMapping, Path: path + ref.path(), ref: ref.path(),
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				return changeMapping(ctx, r, ref, true)
+			}})
+	}
+}
+
+func (p *planner) planIdentityProviders() {
+	if p.desired.IdentityProviders == nil {
+		return
+	}
+	desired := map[string]IdentityProviderRepresentation{}
+	current := map[string]IdentityProviderRepresentation{}
+	var desiredAliases, currentAliases []string
+	for _, provider := range p.desired.IdentityProviders {
+		desired[PString(provider.Alias)] = provider
+		desiredAliases = append(desiredAliases, PString(provider.Alias))
+	}
+	for _, provider := range p.current.IdentityProviders {
+		current[PString(provider.Alias)] = provider
+		currentAliases = append(currentAliases, PString(provider.Alias))
+	}
+	create, both, remove := diffKeys(desiredAliases, currentAliases)
+
+	for _, alias := range create {
+		provider := desired[alias]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileIdentityProvider, Path: "identity-providers/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				provider.InternalID = nil
+				_, _, err := r.g.CreateIdentityProvider(ctx, r.token, r.realm, provider)
+				return err
+			}})
+	}
+	for _, alias := range both {
+		provider, currentProvider := desired[alias], current[alias]
+		fields := changedFields(provider, currentProvider, "internalId")
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileIdentityProvider, Path: "identity-providers/" + alias, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var updated IdentityProviderRepresentation
+				if err := mergeJSON(currentProvider, provider, &updated); err != nil {
+					return err
+				}
+				_, err := r.g.UpdateIdentityProvider(ctx, r.token, r.realm, alias, updated)
+				return err
+			}})
+	}
+	for _, alias := range remove {
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileIdentityProvider, Path: "identity-providers/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteIdentityProvider(ctx, r.token, r.realm, alias)
+				return err
+			}})
+	}
+}
+
+func (p *planner) planRequiredActions() {
+	if p.desired.RequiredActions == nil {
+		return
+	}
+	desired := map[string]RequiredActionProviderRepresentation{}
+	current := map[string]RequiredActionProviderRepresentation{}
+	var desiredAliases, currentAliases []string
+	for _, action := range p.desired.RequiredActions {
+		desired[PString(action.Alias)] = action
+		desiredAliases = append(desiredAliases, PString(action.Alias))
+	}
+	for _, action := range p.current.RequiredActions {
+		current[PString(action.Alias)] = action
+		currentAliases = append(currentAliases, PString(action.Alias))
+	}
+	create, both, remove := diffKeys(desiredAliases, currentAliases)
+
+	for _, alias := range create {
+		action := desired[alias]
+		p.add(PlanStep{Action: ReconcileCreate, Resource: ReconcileRequiredAction, Path: "required-actions/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				// registering only takes the provider and the name, the settings are updated afterwards
+				registered := RequiredActionProviderRepresentation{ProviderID: action.ProviderID, Name: action.Name}
+				if registered.ProviderID == nil {
+					registered.ProviderID = action.Alias
+				}
+				if _, err := r.g.RegisterRequiredAction(ctx, r.token, r.realm, registered); err != nil {
+					return err
+				}
+				_, err := r.g.UpdateRequiredAction(ctx, r.token, r.realm, action)
+				return err
+			}})
+	}
+	for _, alias := range both {
+		action, currentAction := desired[alias], current[alias]
+		fields := changedFields(action, currentAction)
+		if len(fields) == 0 {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileUpdate, Resource: ReconcileRequiredAction, Path: "required-actions/" + alias, Fields: fields,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				var updated RequiredActionProviderRepresentation
+				if err := mergeJSON(currentAction, action, &updated); err != nil {
+					return err
+				}
+				_, err := r.g.UpdateRequiredAction(ctx, r.token, r.realm, updated)
+				return err
+			}})
+	}
+	for _, alias := range remove {
+		if containsString(builtInRequiredActions, alias) {
+			continue
+		}
+		p.add(PlanStep{Action: ReconcileDelete, Resource: ReconcileRequiredAction, Path: "required-actions/" + alias,
+			apply: func(ctx context.Context, r *reconcileRun) error {
+				_, err := r.g.DeleteRequiredAction(ctx, r.token, r.realm, alias)
+				return err
+			}})
+	}
+}
+
+// ----------
+// Comparison
+// ----------
+
+// toJSONValue returns v as decoded by encoding/json into an interface{}
+func toJSONValue(v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+	return value
+}
+
+// changedFields returns the sorted top level fields of desired whose values are not contained in current.
+// Fields missing in desired and the ignored fields are not compared.
+func changedFields(desired, current interface{}, ignore ...string) []string {
+	d, _ := toJSONValue(desired).(map[string]interface{})
+	c, _ := toJSONValue(current).(map[string]interface{})
+
+	fields := []string{}
+	for field, value := range d {
+		if containsString(ignore, field) {
+			continue
+		}
+		if !jsonContains(value, c[field]) {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	return fields
+}
+
+// jsonContains reports whether the decoded JSON value desired is contained in current.
+// Objects match if current has all fields of desired, lists of scalars are compared as sets,
+// other lists element by element and masked secrets match every value.
+func jsonContains(desired, current interface{}) bool {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		c, ok := current.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && current == nil
+		}
+		for key, value := range d {
+			if !jsonContains(value, c[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		c, ok := current.([]interface{})
+		if !ok {
+			return len(d) == 0 && current == nil
+		}
+		if len(d) != len(c) {
+			return false
+		}
+		if scalars(d) && scalars(c) {
+			return reflect.DeepEqual(sortedScalars(d), sortedScalars(c))
+		}
+		for i := range d {
+			if !jsonContains(d[i], c[i]) {
+				return false
+			}
+		}
+		return true
+	case string:
+		if d == secretMask || current == secretMask {
+			return true
+		}
+		return current != nil && d == fmt.Sprint(current)
+	case nil:
+		return current == nil
+	default:
+		return current != nil && fmt.Sprint(d) == fmt.Sprint(current)
+	}
+}
+
+func scalars(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+	}
+	return true
+}
+
+func sortedScalars(values []interface{}) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		result = append(result, fmt.Sprint(value))
+	}
+	sort.Strings(result)
+	return result
+}
+
+// mergeJSON overlays the fields of desired over current and decodes the result into result.
+// Objects are merged recursively, all other values are replaced.
+func mergeJSON(current, desired, result interface{}) error {
+	merged := mergeJSONValues(toJSONValue(current), toJSONValue(desired))
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func mergeJSONValues(current, desired interface{}) interface{} {
+	c, ok := current.(map[string]interface{})
+	d, ok2 := desired.(map[string]interface{})
+	if !ok || !ok2 {
+		return desired
+	}
+	merged := make(map[string]interface{}, len(c))
+	for key, value := range c {
+		merged[key] = value
+	}
+	for key, value := range d {
+		merged[key] = mergeJSONValues(c[key], value)
+	}
+	return merged
+}
+
+// ----------
+// Applying
+// ----------
+
+// reconcileRun caches the ids needed while a plan is applied
+type reconcileRun struct {
+	g     *GoKeycloak
+	token string
+	realm string
+	// clients maps clientId to id, scopes names to ids and groups paths to ids
+	clients map[string]string
+	scopes  map[string]string
+	groups  map[string]string
+	// roles maps role paths to roles with id and name
+	roles map[string]Role
+}
+
+func newReconcileRun(g *GoKeycloak, token string, plan *RealmPlan) *reconcileRun {
+	r := &reconcileRun{
+		g:       g,
+		token:   token,
+		realm:   plan.Realm,
+		clients: map[string]string{},
+		scopes:  map[string]string{},
+		groups:  map[string]string{},
+		roles:   map[string]Role{},
+	}
+	if plan.current == nil {
+		return r
+	}
+	for _, client := range plan.current.Clients {
+		r.clients[PString(client.ClientID)] = PString(client.ID)
+	}
+	for _, scope := range plan.current.ClientScopes {
+		r.scopes[PString(scope.Name)] = PString(scope.ID)
+	}
+	for _, node := range flattenGroups(plan.current.Groups, "") {
+		r.groups[node.path] = PString(node.group.ID)
+	}
+	for _, role := range plan.current.RealmRoles {
+		r.roles[rolePath("", PString(role.Name))] = Role{ID: role.ID, Name: role.Name}
+	}
+	for clientID, roles := range plan.current.ClientRoles {
+		for _, role := range roles {
+			r.roles[rolePath(clientID, PString(role.Name))] = Role{ID: role.ID, Name: role.Name}
+		}
+	}
+
+	return r
+}
+
+func (r *reconcileRun) clientID(ctx context.Context, clientID string) (string, error) {
+	if id, ok := r.clients[clientID]; ok {
+		return id, nil
+	}
+	_, clients, err := r.g.GetClients(ctx, r.token, r.realm, GetClientsParams{ClientID: StringP(clientID)})
+	if err != nil {
+		return "", err
+	}
+	if len(clients) == 0 {
+		return "", errors.Errorf("client %s not found", clientID)
+	}
+	r.clients[clientID] = PString(clients[0].ID)
+	return r.clients[clientID], nil
+}
+
+func (r *reconcileRun) scopeID(ctx context.Context, name string) (string, error) {
+	if id, ok := r.scopes[name]; ok {
+		return id, nil
+	}
+	_, scopes, err := r.g.GetClientScopes(ctx, r.token, r.realm)
+	if err != nil {
+		return "", err
+	}
+	for _, scope := range scopes {
+		r.scopes[PString(scope.Name)] = PString(scope.ID)
+	}
+	if id, ok := r.scopes[name]; ok {
+		return id, nil
+	}
+	return "", errors.Errorf("client scope %s not found", name)
+}
+
+func (r *reconcileRun) groupID(ctx context.Context, path string) (string, error) {
+	if id, ok := r.groups[path]; ok {
+		return id, nil
+	}
+	_, group, err := r.g.GetGroupByPath(ctx, r.token, r.realm, path)
+	if err != nil {
+		return "", err
+	}
+	r.groups[path] = PString(group.ID)
+	return r.groups[path], nil
+}
+
+func (r *reconcileRun) role(ctx context.Context, ref roleRef) (Role, error) {
+	if role, ok := r.roles[ref.path()]; ok {
+		return role, nil
+	}
+	var role *Role
+	var err error
+	if ref.clientID == "" {
+		_, role, err = r.g.GetRealmRole(ctx, r.token, r.realm, ref.name)
+	} else {
+		var idOfClient string
+		if idOfClient, err = r.clientID(ctx, ref.clientID); err != nil {
+			return Role{}, err
+		}
+		_, role, err = r.g.GetClientRole(ctx, r.token, r.realm, idOfClient, ref.name)
+	}
+	if err != nil {
+		return Role{}, err
+	}
+	r.roles[ref.path()] = Role{ID: role.ID, Name: role.Name}
+	return r.roles[ref.path()], nil
+}
+
+// createFlow creates the desired top level flow with its executions, sub flows and configs
+func (r *reconcileRun) createFlow(ctx context.Context, flow AuthenticationFlowRepresentation, flows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation) error {
+	created := AuthenticationFlowRepresentation{Alias: flow.Alias, Description: flow.Description, ProviderID: StringP(flowProviderID(flow)), TopLevel: BoolP(true), BuiltIn: BoolP(false)}
+	if _, err := r.g.CreateAuthenticationFlow(ctx, r.token, r.realm, created); err != nil {
+		return err
+	}
+	return r.addExecutions(ctx, PString(flow.Alias), flow, flows, configs)
+}
+
+// addExecutions adds the executions of the desired flow to the flow with the given alias, creating its sub flows
+// and the configs found in configs
+func (r *reconcileRun) addExecutions(ctx context.Context, alias string, flow AuthenticationFlowRepresentation, flows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation) error {
+	for _, execution := range sortedExecutions(flow) {
+		if isFlowExecution(execution) {
+			subFlow := flows[PString(execution.FlowAlias)]
+			created := CreateAuthenticationExecutionFlowRepresentation{
+				Alias:       execution.FlowAlias,
+				Description: subFlow.Description,
+				Type:        StringP(flowProviderID(subFlow)),
+			}
+			if *created.Type == "form-flow" {
+				created.Provider = StringP("registration-page-form")
+			}
+			if _, err := r.g.CreateAuthenticationExecutionFlow(ctx, r.token, r.realm, alias, created); err != nil {
+				return err
+			}
+		} else {
+			created := CreateAuthenticationExecutionRepresentation{Provider: execution.Authenticator}
+			if _, err := r.g.CreateAuthenticationExecution(ctx, r.token, r.realm, alias, created); err != nil {
+				return err
+			}
+		}
+
+		config, hasConfig := configs[PString(execution.AuthenticatorConfig)]
+		if execution.Requirement != nil || hasConfig {
+			last, err := r.lastExecution(ctx, alias)
+			if err != nil {
+				return err
+			}
+			if execution.Requirement != nil {
+				last.Requirement = execution.Requirement
+				if _, err := r.g.UpdateAuthenticationExecution(ctx, r.token, r.realm, alias, *last); err != nil {
+					return err
+				}
+			}
+			if hasConfig {
+				created := AuthenticatorConfigRepresentation{Alias: config.Alias, Config: config.Config}
+				if _, _, err := r.g.CreateAuthenticatorConfig(ctx, r.token, r.realm, PString(last.ID), created); err != nil {
+					return err
+				}
+			}
+		}
+		if isFlowExecution(execution) {
+			if err := r.addExecutions(ctx, PString(execution.FlowAlias), flows[PString(execution.FlowAlias)], flows, configs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// lastExecution returns the execution added last to the flow
+func (r *reconcileRun) lastExecution(ctx context.Context, alias string) (*ModifyAuthenticationExecutionRepresentation, error) {
+	_, executions, err := r.g.GetAuthenticationExecutions(ctx, r.token, r.realm, alias)
+	if err != nil {
+		return nil, err
+	}
+	var last *ModifyAuthenticationExecutionRepresentation
+	for _, execution := range executions {
+		if PInt(execution.Level) == 0 {
+			last = execution
+		}
+	}
+	if last == nil {
+		return nil, errors.Errorf("authentication flow %s has no executions", alias)
+	}
+	return last, nil
+}
+
+// updateExecutions brings the executions of the flow with the given id in line with the desired flow.
+// Requirements, configs and sub flow descriptions are updated in place. Executions can not be moved between
+// sub flows, so if executions or sub flows were added, removed or reordered, the flow is replaced, see replaceFlow.
+func (r *reconcileRun) updateExecutions(ctx context.Context, id string, flow AuthenticationFlowRepresentation, flows, currentFlows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation) error {
+	alias := PString(flow.Alias)
+	_, executions, err := r.g.GetAuthenticationExecutions(ctx, r.token, r.realm, alias)
+	if err != nil {
+		return err
+	}
+	desired := flowNodes(flow, flows, configs, alias, 0, nil)
+	replace := !sameStructure(desired, currentNodes(alias, executions))
+
+	var subFlows []AuthenticationFlowRepresentation
+	for _, node := range desired {
+		if !node.subFlow {
+			continue
+		}
+		want, have := flows[node.key], currentFlows[node.key]
+		if have.ID == nil || flowProviderID(want) != flowProviderID(have) {
+			replace = true
+			break
+		}
+		if want.Description != nil && PString(want.Description) != PString(have.Description) {
+			have.Description, have.AuthenticationExecutions = want.Description, nil
+			subFlows = append(subFlows, have)
+		}
+	}
+	if replace {
+		return r.replaceFlow(ctx, id, flow, flows, configs)
+	}
+
+	for _, subFlow := range subFlows {
+		if _, _, err := r.g.UpdateAuthenticationFlow(ctx, r.token, r.realm, subFlow, PString(subFlow.ID)); err != nil {
+			return err
+		}
+	}
+	_, err = r.g.syncExecutions(ctx, r.token, r.realm, alias, desired, true)
+	return err
+}
+
+// replaceFlow builds the desired flow next to the flow with the given id and swaps them, so the flow in use stays
+// complete until the new one is. As aliases are unique within a realm, the current flow, its sub flows and configs
+// are renamed first. The desired flow is created under the original aliases, the bindings of the realm, the clients
+// and the identity providers are moved to it and the renamed flow is deleted. If a step fails, the renamed flow
+// is still bound and the error names it.
+func (r *reconcileRun) replaceFlow(ctx context.Context, id string, flow AuthenticationFlowRepresentation, flows map[string]AuthenticationFlowRepresentation, configs map[string]AuthenticatorConfigRepresentation) error {
+	replacedAlias, err := r.renameFlow(ctx, id, "-replaced-"+id)
+	if err != nil {
+		return err
+	}
+	if err := r.createFlow(ctx, flow, flows, configs); err != nil {
+		return errors.Wrapf(err, "the current flow is kept as %s", replacedAlias)
+	}
+	newID, err := r.flowID(ctx, PString(flow.Alias))
+	if err != nil {
+		return err
+	}
+	if err := r.rebindFlow(ctx, id, replacedAlias, newID, PString(flow.Alias)); err != nil {
+		return errors.Wrapf(err, "the current flow is kept as %s", replacedAlias)
+	}
+	if _, err := r.g.DeleteAuthenticationFlow(ctx, r.token, r.realm, id); err != nil {
+		return errors.Wrapf(err, "the replaced flow %s is still in use", replacedAlias)
+	}
+	return nil
+}
+
+// renameFlow appends the suffix to the aliases of the flow, its sub flows and the configs of its executions
+// and returns the new alias of the flow
+func (r *reconcileRun) renameFlow(ctx context.Context, id, suffix string) (string, error) {
+	_, flow, err := r.g.GetAuthenticationFlow(ctx, r.token, r.realm, id)
+	if err != nil {
+		return "", err
+	}
+	_, executions, err := r.g.GetAuthenticationExecutions(ctx, r.token, r.realm, PString(flow.Alias))
+	if err != nil {
+		return "", err
+	}
+	for _, execution := range executions {
+		if !NilOrEmpty(execution.AuthenticationConfig) {
+			_, config, err := r.g.GetAuthenticatorConfig(ctx, r.token, r.realm, PString(execution.AuthenticationConfig))
+			if err != nil {
+				return "", err
+			}
+			config.Alias = StringP(PString(config.Alias) + suffix)
+			if _, err := r.g.UpdateAuthenticatorConfig(ctx, r.token, r.realm, *config); err != nil {
+				return "", err
+			}
+		}
+		if PBool(execution.AuthenticationFlow) && !NilOrEmpty(execution.FlowID) {
+			_, subFlow, err := r.g.GetAuthenticationFlow(ctx, r.token, r.realm, PString(execution.FlowID))
+			if err != nil {
+				return "", err
+			}
+			if err := r.renameFlowAlias(ctx, *subFlow, suffix); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return PString(flow.Alias) + suffix, r.renameFlowAlias(ctx, *flow, suffix)
+}
+
+func (r *reconcileRun) renameFlowAlias(ctx context.Context, flow AuthenticationFlowRepresentation, suffix string) error {
+	flow.Alias, flow.AuthenticationExecutions = StringP(PString(flow.Alias)+suffix), nil
+	_, _, err := r.g.UpdateAuthenticationFlow(ctx, r.token, r.realm, flow, PString(flow.ID))
+	return err
+}
+
+func (r *reconcileRun) flowID(ctx context.Context, alias string) (string, error) {
+	_, flows, err := r.g.GetAuthenticationFlows(ctx, r.token, r.realm)
+	if err != nil {
+		return "", err
+	}
+	for _, flow := range flows {
+		if PString(flow.Alias) == alias {
+			return PString(flow.ID), nil
+		}
+	}
+	return "", errors.Errorf("authentication flow %s not found", alias)
+}
+
+// rebindFlow moves the flow bindings of the realm, the flow overrides of the clients and the login flows
+// of the identity providers from one flow to another
+func (r *reconcileRun) rebindFlow(ctx context.Context, fromID, fromAlias, toID, toAlias string) error {
+	_, realm, err := r.g.GetRealm(ctx, r.token, r.realm)
+	if err != nil {
+		return err
+	}
+	bindings := RealmRepresentation{Realm: StringP(r.realm)}
+	rebound := false
+	for _, binding := range []struct {
+		current *string
+		updated **string
+	}{
+		{realm.BrowserFlow, &bindings.BrowserFlow},
+		{realm.RegistrationFlow, &bindings.RegistrationFlow},
+		{realm.DirectGrantFlow, &bindings.DirectGrantFlow},
+		{realm.ResetCredentialsFlow, &bindings.ResetCredentialsFlow},
+		{realm.ClientAuthenticationFlow, &bindings.ClientAuthenticationFlow},
+		{realm.DockerAuthenticationFlow, &bindings.DockerAuthenticationFlow},
+	} {
+		if PString(binding.current) == fromAlias {
+			*binding.updated, rebound = StringP(toAlias), true
+		}
+	}
+	if rebound {
+		if _, err := r.g.UpdateRealm(ctx, r.token, bindings); err != nil {
+			return err
+		}
+	}
+
+	clients, err := r.g.getAdminClients(ctx, r.token, r.realm)
+	if err != nil {
+		return err
+	}
+	for _, client := range clients {
+		if client.AuthenticationFlowBindingOverrides == nil {
+			continue
+		}
+		overrides := map[string]interface{}{}
+		for binding, flowID := range *client.AuthenticationFlowBindingOverrides {
+			if flowID == fromID {
+				overrides[binding] = toID
+			}
+		}
+		if len(overrides) == 0 {
+			continue
+		}
+		if err := r.g.updateAdminClient(ctx, r.token, r.realm, PString(client.ID), map[string]interface{}{"authenticationFlowBindingOverrides": overrides}); err != nil {
+			return err
+		}
+	}
+
+	_, providers, err := r.g.GetIdentityProviders(ctx, r.token, r.realm)
+	if err != nil {
+		return err
+	}
+	for _, provider := range providers {
+		rebound := false
+		for _, flowAlias := range []**string{&provider.FirstBrokerLoginFlowAlias, &provider.PostBrokerLoginFlowAlias} {
+			if PString(*flowAlias) == fromAlias {
+				*flowAlias, rebound = StringP(toAlias), true
+			}
+		}
+		if !rebound {
+			continue
+		}
+		if _, err := r.g.UpdateIdentityProvider(ctx, r.token, r.realm, PString(provider.Alias), *provider); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getAdminClients gets the clients of a realm with the admin API like GetClients.
+// Unlike GetClients, the camel case names of the admin API are decoded.
+func (g *GoKeycloak) getAdminClients(ctx context.Context, token, realm string) ([]Client, error) {
+	const errMessage = "could not get clients"
+
+	var result []adminClient
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return nil, err
+	}
+	return adminClients(result), nil
+}
+
+// createAdminClient creates a client with the admin API, unlike CreateClient which uses the client registration API
+func (g *GoKeycloak) createAdminClient(ctx context.Context, token, realm string, client Client) (string, error) {
+	const errMessage = "could not create client"
+
+	representation, err := client.adminRepresentation()
+	if err != nil {
+		return "", errors.Wrap(err, errMessage)
+	}
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(representation).
+		Post(g.getAdminRealmURL(realm, "clients"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return "", err
+	}
+	return getID(resp), nil
+}
+
+// updateAdminClient updates a client with the camel case representation of the admin API
+func (g *GoKeycloak) updateAdminClient(ctx context.Context, token, realm, idOfClient string, representation map[string]interface{}) error {
+	const errMessage = "could not update client"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(representation).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient))
+
+	return checkForError(resp, err, errMessage)
+}
+
+func (g *GoKeycloak) createAdminClientProtocolMapper(ctx context.Context, token, realm, idOfClient string, representation map[string]json.RawMessage) error {
+	const errMessage = "could not create client protocol mapper"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(representation).
+		Post(g.getAdminRealmURL(realm, "clients", idOfClient, "protocol-mappers", "models"))
+
+	return checkForError(resp, err, errMessage)
+}
+
+func (g *GoKeycloak) updateAdminClientProtocolMapper(ctx context.Context, token, realm, idOfClient, mapperID string, representation map[string]interface{}) error {
+	const errMessage = "could not update client protocol mapper"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetBody(representation).
+		Put(g.getAdminRealmURL(realm, "clients", idOfClient, "protocol-mappers", "models", mapperID))
+
+	return checkForError(resp, err, errMessage)
+}
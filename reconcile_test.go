@@ -0,0 +1,223 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeReconcileRealm serves realm test with the clients app, legacy and admin-cli, it accepts every change
+func fakeReconcileRealm(t *testing.T) *fakeServer {
+	t.Helper()
+
+	responses := map[string]string{
+		"clients": `[
+			{"id": "c1", "clientId": "app", "redirectUris": ["https://a"], "publicClient": true,
+			 "protocolMappers": [{"id": "m1", "name": "old", "protocol": "openid-connect", "protocolMapper": "oidc-hardcoded-claim-mapper"}]},
+			{"id": "c2", "clientId": "legacy"},
+			{"id": "c3", "clientId": "admin-cli"}]`,
+		"clients/c1/roles":                `[]`,
+		"clients/c2/roles":                `[]`,
+		"clients/c3/roles":                `[]`,
+		"roles":                           `[{"id": "r1", "name": "viewer"}, {"id": "r2", "name": "offline_access"}]`,
+		"groups":                          `[{"id": "g1", "name": "staff", "path": "/staff", "realmRoles": ["viewer"], "subGroups": []}]`,
+		"client-scopes":                   `[]`,
+		"identity-provider/instances":     `[]`,
+		"authentication/required-actions": `[]`,
+		"authentication/flows":            `[]`,
+	}
+
+	return newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/admin/realms/test/")
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			response, ok := responses[path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.Header().Set("Location", r.URL.String()+"/new-id")
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func reconcileDesiredRealm(t *testing.T) *gokeycloak.RealmRepresentation {
+	t.Helper()
+
+	desired, err := gokeycloak.ParseRealm([]byte(`{
+		"realm": "test",
+		"clients": [
+			{"clientId": "app", "redirectUris": ["https://a", "https://b"], "publicClient": true, "protocolMappers": []},
+			{"clientId": "new", "rootUrl": "https://new"}
+		],
+		"roles": {"realm": [
+			{"name": "viewer"},
+			{"name": "editor", "composite": true, "composites": {"realm": ["viewer"]}}
+		]},
+		"groups": [{"name": "staff", "realmRoles": ["editor"], "subGroups": [{"name": "team"}]}]
+	}`))
+	require.NoError(t, err)
+	return desired
+}
+
+// realmChanges returns the method, the path in the realm and the JSON body of the changes received by server
+func realmChanges(server *fakeServer) []string {
+	var changes []string
+	for _, request := range server.recorded() {
+		if request.Method == http.MethodGet {
+			continue
+		}
+		var body interface{}
+		_ = json.Unmarshal(request.Body, &body)
+		data, _ := json.Marshal(body)
+		changes = append(changes, request.Method+" "+strings.TrimPrefix(request.Path, "/admin/realms/test/")+" "+string(data))
+	}
+	return changes
+}
+
+func Test_PlanRealm(t *testing.T) {
+	t.Parallel()
+
+	client := fakeReconcileRealm(t).client()
+	desired := reconcileDesiredRealm(t)
+	ctx := context.Background()
+
+	plan, err := client.PlanRealm(ctx, "token", *desired, gokeycloak.ReconcileOptions{})
+	require.NoError(t, err)
+	require.Equal(t, `realm test: 9 changes
++ client clients/new
+~ client clients/app (redirectUris)
++ realm-role roles/editor
++ role-composite roles/editor/composites/roles/viewer
++ group groups/staff/team
++ group-role-mapping groups/staff/role-mappings/roles/editor
+- group-role-mapping groups/staff/role-mappings/roles/viewer
+- client-protocol-mapper clients/app/protocol-mappers/old
+- client clients/legacy
+`, plan.String())
+
+	plan, err = client.PlanRealm(ctx, "token", *desired, gokeycloak.ReconcileOptions{NoDelete: true})
+	require.NoError(t, err)
+	for _, step := range plan.Steps {
+		require.NotEqual(t, gokeycloak.ReconcileDelete, step.Action, step.Path)
+	}
+	require.Len(t, plan.Steps, 6)
+}
+
+func Test_ApplyRealmPlan(t *testing.T) {
+	t.Parallel()
+
+	server := fakeReconcileRealm(t)
+	client := server.client()
+	ctx := context.Background()
+
+	plan, err := client.PlanRealm(ctx, "token", *reconcileDesiredRealm(t), gokeycloak.ReconcileOptions{})
+	require.NoError(t, err)
+	require.NoError(t, client.ApplyRealmPlan(ctx, "token", plan))
+	require.Equal(t, []string{
+		`POST clients {"clientId":"new","rootUrl":"https://new"}`,
+		`PUT clients/c1 {"clientId":"app","id":"c1","publicClient":true,"redirectUris":["https://a","https://b"]}`,
+		`POST roles {"name":"editor"}`,
+		`POST roles/editor/composites [{"id":"r1","name":"viewer"}]`,
+		`POST groups/g1/children {"name":"team"}`,
+		`POST groups/g1/role-mappings/realm [{"id":"new-id","name":"editor"}]`,
+		`DELETE groups/g1/role-mappings/realm [{"id":"r1","name":"viewer"}]`,
+		`DELETE clients/c1/protocol-mappers/models/m1 null`,
+		`DELETE clients/c2 null`,
+	}, realmChanges(server))
+}
+
+func Test_ReconcileAuthenticationFlows(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string]string{
+		"clients/c1/roles":                `[]`,
+		"roles":                           `[]`,
+		"groups":                          `[]`,
+		"client-scopes":                   `[]`,
+		"identity-provider/instances":     `[]`,
+		"authentication/required-actions": `[]`,
+	}
+	fake := newFakeFlows()
+	fake.clients = []map[string]interface{}{
+		{"id": "c1", "clientId": "app", "authenticationFlowBindingOverrides": map[string]interface{}{}},
+	}
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if response, ok := responses[strings.TrimPrefix(r.URL.Path, "/admin/realms/test/")]; ok && r.Method == http.MethodGet {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		fake.ServeHTTP(w, r)
+	})
+
+	client := server.client()
+	ctx := context.Background()
+	reconcile := func(realm string) string {
+		desired, err := gokeycloak.ParseRealm([]byte(realm))
+		require.NoError(t, err)
+		_, err = client.ReconcileRealm(ctx, "token", *desired, gokeycloak.ReconcileOptions{})
+		require.NoError(t, err)
+		plan, err := client.PlanRealm(ctx, "token", *desired, gokeycloak.ReconcileOptions{})
+		require.NoError(t, err)
+		require.True(t, plan.Empty(), plan.String())
+		return *fake.flow("browser-otp").ID
+	}
+	realm := func(redirectorRequirement, provider, formsDescription, otp string) string {
+		return `{
+			"realm": "test",
+			"authenticationFlows": [
+				{"alias": "browser-otp", "providerId": "basic-flow", "topLevel": true, "builtIn": false, "authenticationExecutions": [
+					{"authenticator": "auth-cookie", "requirement": "ALTERNATIVE", "priority": 10},
+					{"authenticator": "identity-provider-redirector", "requirement": "` + redirectorRequirement + `", "priority": 20,
+					 "authenticatorConfig": "redirector"},
+					{"flowAlias": "browser-otp forms", "authenticatorFlow": true, "requirement": "ALTERNATIVE", "priority": 30}]},
+				{"alias": "browser-otp forms", "description": "` + formsDescription + `", "providerId": "basic-flow", "topLevel": false,
+				 "builtIn": false, "authenticationExecutions": [
+					{"authenticator": "auth-username-password-form", "requirement": "REQUIRED", "priority": 10}` + otp + `]}
+			],
+			"authenticatorConfig": [{"alias": "redirector", "config": {"defaultProvider": "` + provider + `"}}]
+		}`
+	}
+	otp := `, {"authenticator": "auth-otp-form", "requirement": "REQUIRED", "priority": 20}`
+
+	id := reconcile(realm("ALTERNATIVE", "idp", "forms", ""))
+	fake.mu.Lock()
+	fake.bindings["browserFlow"] = id
+	fake.clients[0]["authenticationFlowBindingOverrides"] = map[string]interface{}{"browser": id}
+	fake.mu.Unlock()
+	executions := fake.executionsOf("browser-otp")
+	require.Len(t, executions, 3)
+
+	// configs, requirements and sub flow descriptions are updated in place
+	require.Equal(t, id, reconcile(realm("DISABLED", "other", "login forms", "")))
+	require.Equal(t, executions, fake.executionsOf("browser-otp"))
+	require.Equal(t, "DISABLED", executions[1].requirement)
+	require.Equal(t, "other", (*fake.configs[executions[1].config].Config)["defaultProvider"])
+	require.Equal(t, "login forms", *fake.flow("browser-otp forms").Description)
+
+	// a new execution replaces the flow and moves its bindings
+	newID := reconcile(realm("DISABLED", "other", "login forms", otp))
+	require.NotEqual(t, id, newID)
+	require.Len(t, fake.executionsOf("browser-otp forms"), 2)
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	require.Equal(t, newID, fake.bindings["browserFlow"])
+	require.Equal(t, map[string]interface{}{"browser": newID}, fake.clients[0]["authenticationFlowBindingOverrides"])
+	require.Nil(t, fake.flow(id))
+	require.Len(t, fake.flows, 2)
+	require.Len(t, fake.configs, 1)
+}
@@ -0,0 +1,301 @@
+package gokeycloak
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownTenant is returned by the Registry if no tenant is configured for a realm and host
+var ErrUnknownTenant = errors.New("unknown tenant")
+
+// tokenExpirySkew is subtracted from the lifetime of service account tokens, so they are renewed before they expire
+const tokenExpirySkew = 10 * time.Second
+
+// TenantKey identifies a tenant by the host of its Keycloak server and its realm
+type TenantKey struct {
+	Host  string
+	Realm string
+}
+
+// TenantConfig is the configuration of a tenant of a Registry
+type TenantConfig struct {
+	// BasePath is the URL of the Keycloak server
+	BasePath string
+	// Realm is the realm of the tenant
+	Realm string
+	// ClientID and ClientSecret are the credentials of the service account of the tenant
+	ClientID     string
+	ClientSecret string
+	// Issuer is the iss claim of the tokens of the tenant.
+	// Only needed if Keycloak is reached under another host than the one tokens are issued for.
+	Issuer string
+	// Options are passed to NewClient when the client of the tenant is built
+	Options []func(*GoKeycloak)
+}
+
+// Key returns the key of the tenant, using the host of the issuer if set and of the base path otherwise
+func (c TenantConfig) Key() (TenantKey, error) {
+	rawURL := c.BasePath
+	if c.Issuer != "" {
+		rawURL = c.Issuer
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return TenantKey{}, errors.Wrap(err, "could not parse tenant url")
+	}
+	if u.Host == "" || c.Realm == "" {
+		return TenantKey{}, errors.Errorf("tenant needs a host and a realm, got %q and %q", rawURL, c.Realm)
+	}
+
+	return TenantKey{Host: u.Host, Realm: c.Realm}, nil
+}
+
+// RegistryConfig is the configuration of a Registry
+type RegistryConfig struct {
+	// Tenants are the known tenants, their clients are built on first use
+	Tenants []TenantConfig
+	// IdleTimeout is the time after which the client of an unused tenant is dropped (default 30m)
+	IdleTimeout time.Duration
+}
+
+// Registry holds a lazily built client per tenant.
+// Every tenant has its own client, certificate cache and service account token.
+// A Registry is safe for concurrent use.
+type Registry struct {
+	idleTimeout time.Duration
+	now         func() time.Time
+
+	mu        sync.Mutex
+	configs   map[TenantKey]TenantConfig
+	tenants   map[TenantKey]*Tenant
+	lastSweep time.Time
+}
+
+// Tenant is a tenant of a Registry
+type Tenant struct {
+	key      TenantKey
+	client   *GoKeycloak
+	lastUsed atomic.Int64
+
+	mu      sync.Mutex
+	config  TenantConfig
+	token   *JWT
+	expires time.Time
+}
+
+// NewRegistry creates a new Registry
+func NewRegistry(config RegistryConfig) (*Registry, error) {
+	if config.IdleTimeout <= 0 {
+		config.IdleTimeout = 30 * time.Minute
+	}
+
+	r := &Registry{
+		idleTimeout: config.IdleTimeout,
+		now:         time.Now,
+		tenants:     map[TenantKey]*Tenant{},
+	}
+	if err := r.Reload(config.Tenants); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload replaces the tenant configurations without dropping unchanged tenants.
+// Tenants with changed credentials get a new service account token on their next use,
+// tenants with a changed base path or issuer are rebuilt and removed tenants are dropped.
+func (r *Registry) Reload(tenants []TenantConfig) error {
+	configs := make(map[TenantKey]TenantConfig, len(tenants))
+	for _, config := range tenants {
+		key, err := config.Key()
+		if err != nil {
+			return err
+		}
+		if _, ok := configs[key]; ok {
+			return errors.Errorf("duplicate tenant %s/%s", key.Host, key.Realm)
+		}
+		configs[key] = config
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.configs = configs
+	for key, tenant := range r.tenants {
+		config, ok := configs[key]
+		if !ok || tenant.config.BasePath != config.BasePath || tenant.config.Issuer != config.Issuer {
+			delete(r.tenants, key)
+			continue
+		}
+		tenant.setCredentials(config)
+	}
+
+	return nil
+}
+
+// Tenant returns the tenant of the given Keycloak host and realm, building its client if needed
+func (r *Registry) Tenant(host, realm string) (*Tenant, error) {
+	key := TenantKey{Host: host, Realm: realm}
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sweep(now)
+	tenant, ok := r.tenants[key]
+	if !ok {
+		config, ok := r.configs[key]
+		if !ok {
+			return nil, errors.Wrapf(ErrUnknownTenant, "%s/%s", host, realm)
+		}
+		tenant = &Tenant{
+			key:    key,
+			client: NewClient(config.BasePath, config.Options...),
+			config: config,
+		}
+		r.tenants[key] = tenant
+	}
+	tenant.touch(now)
+
+	return tenant, nil
+}
+
+// TenantForToken resolves the tenant from the iss claim of the given token.
+// The token is not verified, use DecodeAccessToken for that.
+func (r *Registry) TenantForToken(accessToken string) (*Tenant, error) {
+	const errMessage = "could not resolve tenant"
+
+	claims := jwt.RegisteredClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(strings.Replace(accessToken, "Bearer ", "", 1), &claims)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	key, err := parseIssuer(claims.Issuer)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+
+	return r.Tenant(key.Host, key.Realm)
+}
+
+// DecodeAccessToken resolves the tenant of the token and verifies it against the certificates of that tenant
+func (r *Registry) DecodeAccessToken(ctx context.Context, accessToken string) (*Tenant, *jwt.Token, *jwt.MapClaims, error) {
+	tenant, err := r.TenantForToken(accessToken)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	_, token, claims, err := tenant.client.DecodeAccessToken(ctx, accessToken, tenant.key.Realm)
+	if err != nil {
+		return tenant, nil, nil, err
+	}
+
+	return tenant, token, claims, nil
+}
+
+// EvictIdle drops all tenants which were not used within the idle timeout and returns their number.
+// Idle tenants are also dropped while looking up tenants, so calling it is optional.
+func (r *Registry) EvictIdle() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.evict(r.now())
+}
+
+// sweep evicts idle tenants at most once per idle timeout. Requires r.mu.
+func (r *Registry) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < r.idleTimeout {
+		return
+	}
+	r.evict(now)
+}
+
+// evict drops the idle tenants. Requires r.mu.
+func (r *Registry) evict(now time.Time) int {
+	r.lastSweep = now
+
+	evicted := 0
+	for key, tenant := range r.tenants {
+		if now.Sub(tenant.lastUse()) >= r.idleTimeout {
+			delete(r.tenants, key)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
+// parseIssuer returns the tenant key of an issuer URL like https://host/realms/realm
+func parseIssuer(issuer string) (TenantKey, error) {
+	u, err := url.Parse(issuer)
+	if err != nil {
+		return TenantKey{}, err
+	}
+	segments := strings.Split(strings.Trim(u.Path, urlSeparator), urlSeparator)
+	if u.Host == "" || len(segments) < 2 || segments[len(segments)-2] != "realms" {
+		return TenantKey{}, errors.Errorf("invalid issuer %q", issuer)
+	}
+
+	return TenantKey{Host: u.Host, Realm: segments[len(segments)-1]}, nil
+}
+
+// Key returns the key of the tenant
+func (t *Tenant) Key() TenantKey {
+	return t.key
+}
+
+// Realm returns the realm of the tenant
+func (t *Tenant) Realm() string {
+	return t.key.Realm
+}
+
+// Client returns the client of the tenant
+func (t *Tenant) Client() *GoKeycloak {
+	return t.client
+}
+
+// Token returns an access token of the service account of the tenant.
+// The token is cached and renewed shortly before it expires.
+func (t *Tenant) Token(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.token != nil && time.Now().Before(t.expires) {
+		return t.token.AccessToken, nil
+	}
+
+	_, token, err := t.client.LoginClient(ctx, t.config.ClientID, t.config.ClientSecret, t.key.Realm)
+	if err != nil {
+		return "", err
+	}
+	t.token = token
+	t.expires = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpirySkew)
+
+	return token.AccessToken, nil
+}
+
+// setCredentials updates the service account credentials and drops the token if they changed
+func (t *Tenant) setCredentials(config TenantConfig) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.config.ClientID != config.ClientID || t.config.ClientSecret != config.ClientSecret {
+		t.token = nil
+	}
+	t.config = config
+}
+
+func (t *Tenant) touch(now time.Time) {
+	t.lastUsed.Store(now.UnixNano())
+}
+
+func (t *Tenant) lastUse() time.Time {
+	return time.Unix(0, t.lastUsed.Load())
+}
@@ -0,0 +1,122 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_Registry(t *testing.T) {
+	t.Parallel()
+
+	keys := map[string]*rsa.PrivateKey{}
+	for _, realm := range []string{"a", "b"} {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		keys[realm] = key
+	}
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(r.URL.Path, "/")
+		realm := segments[2]
+		w.Header().Set("Content-Type", "application/json")
+		switch segments[len(segments)-1] {
+		case "certs":
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"keys": []map[string]string{{
+					"kid": realm,
+					"kty": "RSA",
+					"alg": "RS256",
+					"n":   base64.RawURLEncoding.EncodeToString(keys[realm].N.Bytes()),
+					"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(keys[realm].E)).Bytes()),
+				}},
+			})
+		case "token":
+			_, secret, _ := r.BasicAuth()
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"access_token": realm + ":" + secret,
+				"expires_in":   300,
+			})
+		}
+	})
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	registry, err := gokeycloak.NewRegistry(gokeycloak.RegistryConfig{
+		Tenants: []gokeycloak.TenantConfig{
+			{BasePath: server.URL, Realm: "a", ClientID: "svc", ClientSecret: "secret-a"},
+			{BasePath: server.URL, Realm: "b", ClientID: "svc", ClientSecret: "secret-b"},
+		},
+		IdleTimeout: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	sign := func(realm, kid string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss": (&url.URL{Scheme: "http", Host: host, Path: "/realms/" + realm}).String(),
+			"sub": "user-" + realm,
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(keys[kid])
+		require.NoError(t, err)
+		return signed
+	}
+	ctx := context.Background()
+
+	tenant, _, claims, err := registry.DecodeAccessToken(ctx, sign("b", "b"))
+	require.NoError(t, err)
+	require.Equal(t, "b", tenant.Realm())
+	require.Equal(t, "user-b", (*claims)["sub"])
+
+	_, _, _, err = registry.DecodeAccessToken(ctx, sign("a", "b"))
+	require.Error(t, err, "a token of realm a signed with the key of realm b must be rejected")
+
+	_, err = registry.TenantForToken(sign("c", "a"))
+	require.ErrorIs(t, err, gokeycloak.ErrUnknownTenant)
+
+	tenant, err = registry.Tenant(host, "a")
+	require.NoError(t, err)
+	token, err := tenant.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a:secret-a", token)
+	_, err = tenant.Token(ctx)
+	require.NoError(t, err)
+	var logins int
+	for _, request := range server.recorded() {
+		if strings.HasSuffix(request.Path, "/token") {
+			logins++
+		}
+	}
+	require.Equal(t, 1, logins, "the service account token must be cached")
+
+	require.NoError(t, registry.Reload([]gokeycloak.TenantConfig{
+		{BasePath: server.URL, Realm: "a", ClientID: "svc", ClientSecret: "rotated"},
+	}))
+	reloaded, err := registry.Tenant(host, "a")
+	require.NoError(t, err)
+	require.Same(t, tenant, reloaded)
+	token, err = reloaded.Token(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "a:rotated", token)
+	_, err = registry.Tenant(host, "b")
+	require.ErrorIs(t, err, gokeycloak.ErrUnknownTenant)
+
+	time.Sleep(60 * time.Millisecond)
+	require.Equal(t, 1, registry.EvictIdle())
+	rebuilt, err := registry.Tenant(host, "a")
+	require.NoError(t, err)
+	require.NotSame(t, tenant, rebuilt)
+}
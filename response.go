@@ -0,0 +1,89 @@
+package gokeycloak
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+//go:generate go run ./internal/cmd/genv2 -output client_v2.go
+
+// Response holds the details of the HTTP response of a call made through GoKeycloakV2
+type Response struct {
+	// StatusCode is the HTTP status code, 0 if the request failed before a response was received
+	StatusCode int
+	// Header holds the response headers, e.g. ETag or Location
+	Header http.Header
+	// ID is the ID of a created resource, taken from the last segment of the Location header
+	ID string
+	// Duration is the time between sending the request and receiving the response
+	Duration time.Duration
+	// ReceivedAt is the time the response was received
+	ReceivedAt time.Time
+}
+
+// GoKeycloakV2 exposes the calls of GoKeycloak with consistent return values.
+// Every call returns its result, the HTTP response and an error.
+// Every call accepts RequestOptions and runs the hooks added with AddBeforeCallHook and AddAfterCallHook.
+// If a call sends several requests, the response of the last one is returned.
+// The response is nil if no request was sent, e.g. because the certificates were cached
+// or the parameters were invalid.
+type GoKeycloakV2 struct {
+	g *GoKeycloak
+}
+
+// V2 returns the v2 surface of the client
+func (g *GoKeycloak) V2() *GoKeycloakV2 {
+	return &GoKeycloakV2{g: g}
+}
+
+var responseRecorderContextKey = contextKey("responseRecorder")
+
+// responseRecorder keeps the last response of the requests sent with its context
+type responseRecorder struct {
+	mu   sync.Mutex
+	resp *Response
+}
+
+// recordResponses returns a context which records the responses of all requests sent with it
+func recordResponses(ctx context.Context) (context.Context, *responseRecorder) {
+	recorder := &responseRecorder{}
+	return context.WithValue(ctx, responseRecorderContextKey, recorder), recorder
+}
+
+func (r *responseRecorder) response() *Response {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.resp
+}
+
+// recordResponse stores resp in the recorder of the request context, if any
+func recordResponse(resp *resty.Response) {
+	if resp == nil || resp.Request == nil {
+		return
+	}
+	recorder, ok := resp.Request.Context().Value(responseRecorderContextKey).(*responseRecorder)
+	if !ok {
+		return
+	}
+
+	response := newResponse(resp)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	recorder.resp = response
+}
+
+func newResponse(resp *resty.Response) *Response {
+	return &Response{
+		StatusCode: resp.StatusCode(),
+		Header:     resp.Header(),
+		ID:         getID(resp),
+		Duration:   resp.Time(),
+		ReceivedAt: resp.ReceivedAt(),
+	}
+}
@@ -0,0 +1,49 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+func Test_V2_Response(t *testing.T) {
+	t.Parallel()
+
+	server := newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/admin/realms/test/users":
+			w.Header().Set("Location", "http://keycloak/admin/realms/test/users/4711")
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodGet && r.URL.Path == "/admin/realms/test/components/c1":
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("ETag", `"v1"`)
+			_, _ = w.Write([]byte(`{"id":"c1","name":"rsa"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	client := gokeycloak.NewClient(server.URL).V2()
+	ctx := context.Background()
+
+	userID, resp, err := client.CreateUser(ctx, "token", "test", gokeycloak.User{Username: gokeycloak.StringP("test")})
+	require.NoError(t, err)
+	require.Equal(t, "4711", userID)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	require.Equal(t, "4711", resp.ID)
+	require.False(t, resp.ReceivedAt.IsZero())
+
+	component, resp, err := client.GetComponent(ctx, "token", "test", "c1")
+	require.NoError(t, err)
+	require.Equal(t, "rsa", gokeycloak.PString(component.Name))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, `"v1"`, resp.Header.Get("ETag"))
+
+	_, resp, err = client.GetComponent(ctx, "token", "test", "unknown")
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
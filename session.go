@@ -0,0 +1,325 @@
+package gokeycloak
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// GetClientSessionStats returns the number of active and offline sessions of the clients of the realm which have sessions
+func (g *GoKeycloak) GetClientSessionStats(ctx context.Context, token, realm string) (int, []*ClientSessionStats, error) {
+	const errMessage = "could not get client session stats"
+
+	var result []*ClientSessionStats
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "client-session-stats"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), result, nil
+}
+
+// LogoutAllUsers removes all user sessions of the realm and pushes a logout to the clients with an admin URL
+func (g *GoKeycloak) LogoutAllUsers(ctx context.Context, token, realm string) (int, *GlobalRequestResult, error) {
+	const errMessage = "could not logout all users"
+
+	var result GlobalRequestResult
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "logout-all"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// PushRevocation pushes the not before policy of the realm to the clients with an admin URL
+func (g *GoKeycloak) PushRevocation(ctx context.Context, token, realm string) (int, *GlobalRequestResult, error) {
+	const errMessage = "could not push revocation"
+
+	var result GlobalRequestResult
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Post(g.getAdminRealmURL(realm, "push-revocation"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// GetClientSessionCount returns the number of active user sessions of the client
+func (g *GoKeycloak) GetClientSessionCount(ctx context.Context, token, realm, idOfClient string) (int, int64, error) {
+	return g.getClientSessionCount(ctx, token, realm, idOfClient, "session-count")
+}
+
+// GetClientOfflineSessionCount returns the number of offline sessions of the client
+func (g *GoKeycloak) GetClientOfflineSessionCount(ctx context.Context, token, realm, idOfClient string) (int, int64, error) {
+	return g.getClientSessionCount(ctx, token, realm, idOfClient, "offline-session-count")
+}
+
+func (g *GoKeycloak) getClientSessionCount(ctx context.Context, token, realm, idOfClient, endpoint string) (int, int64, error) {
+	const errMessage = "could not get client session count"
+
+	var result struct {
+		Count int64 `json:"count"`
+	}
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, endpoint))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), 0, err
+	}
+
+	return resp.StatusCode(), result.Count, nil
+}
+
+// getClientSessions returns a page of the active or offline sessions of the client
+func (g *GoKeycloak) getClientSessions(ctx context.Context, token, realm, idOfClient string, offline bool, params GetSessionsParams) ([]*UserSessionRepresentation, error) {
+	const errMessage = "could not get client sessions"
+
+	queryParams, err := GetQueryParams(params)
+	if err != nil {
+		return nil, errors.Wrap(err, errMessage)
+	}
+	endpoint := "user-sessions"
+	if offline {
+		endpoint = "offline-sessions"
+	}
+
+	var result []*UserSessionRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetQueryParams(queryParams).
+		Get(g.getAdminRealmURL(realm, "clients", idOfClient, endpoint))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RealmSession is a session of a SessionInventory
+type RealmSession struct {
+	Session *UserSessionRepresentation
+	Offline bool
+}
+
+// SessionInventoryOptions are the options of GetSessionInventory
+type SessionInventoryOptions struct {
+	// ClientIDs restricts the inventory to the clients with these clientIds, all clients by default
+	ClientIDs []string
+	// Offline adds the offline sessions
+	Offline bool
+	// PageSize is the number of sessions requested at once, DefaultPageSize by default
+	PageSize int
+}
+
+// SessionInventory is a snapshot of the sessions of a realm, see GetSessionInventory
+type SessionInventory struct {
+	Realm    string
+	Sessions []*RealmSession
+	// ByClient holds the sessions keyed by the clientId of their clients
+	ByClient map[string][]*RealmSession
+	// ByUser holds the sessions keyed by the id of their user
+	ByUser map[string][]*RealmSession
+}
+
+// SessionFilter selects sessions of a SessionInventory. The zero value selects all sessions.
+type SessionFilter struct {
+	// IPAddress is an IP address or a CIDR range, e.g. 10.0.0.0/8
+	IPAddress string
+	// StartedBefore and StartedAfter restrict the start time of the sessions
+	StartedBefore time.Time
+	StartedAfter  time.Time
+	// ClientIDs selects the sessions of any of these clientIds
+	ClientIDs []string
+	// UserIDs selects the sessions of any of these users
+	UserIDs []string
+	// Offline selects either the active or the offline sessions
+	Offline *bool
+}
+
+// GetSessionInventory reads the sessions of the realm page by page for every client with sessions.
+// A session used by several clients is listed once in Sessions and for each client in ByClient.
+func (g *GoKeycloak) GetSessionInventory(ctx context.Context, token, realm string, options SessionInventoryOptions) (*SessionInventory, error) {
+	_, stats, err := g.GetClientSessionStats(ctx, token, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory := &SessionInventory{
+		Realm:    realm,
+		Sessions: []*RealmSession{},
+		ByClient: map[string][]*RealmSession{},
+		ByUser:   map[string][]*RealmSession{},
+	}
+	seen := map[sessionKey]*RealmSession{}
+	for _, stat := range stats {
+		clientID := PString(stat.ClientID)
+		if len(options.ClientIDs) > 0 && !containsString(options.ClientIDs, clientID) {
+			continue
+		}
+
+		kinds := []bool{false}
+		if options.Offline {
+			kinds = append(kinds, true)
+		}
+		for _, offline := range kinds {
+			pager := g.clientSessionsPager(token, realm, PString(stat.ID), offline, GetSessionsParams{}, options.PageSize)
+			for {
+				sessions, err := pager.Next(ctx)
+				if errors.Is(err, ErrPagerDone) {
+					break
+				}
+				if err != nil {
+					return nil, err
+				}
+				for _, session := range sessions {
+					item := inventory.addSession(seen, session, offline)
+					inventory.ByClient[clientID] = append(inventory.ByClient[clientID], item)
+				}
+			}
+		}
+	}
+
+	return inventory, nil
+}
+
+// sessionKey identifies a session of the inventory, an offline session has the id of the user session it was created from
+type sessionKey struct {
+	id      string
+	offline bool
+}
+
+func (i *SessionInventory) addSession(seen map[sessionKey]*RealmSession, session *UserSessionRepresentation, offline bool) *RealmSession {
+	key := sessionKey{id: PString(session.ID), offline: offline}
+	if item, ok := seen[key]; ok {
+		return item
+	}
+
+	item := &RealmSession{Session: session, Offline: offline}
+	seen[key] = item
+	i.Sessions = append(i.Sessions, item)
+	userID := PString(session.UserID)
+	i.ByUser[userID] = append(i.ByUser[userID], item)
+	return item
+}
+
+// Filter returns the sessions selected by the filter
+func (i *SessionInventory) Filter(filter SessionFilter) ([]*RealmSession, error) {
+	match, err := filter.matcher()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*RealmSession{}
+	for _, session := range i.Sessions {
+		if match(session) {
+			result = append(result, session)
+		}
+	}
+	return result, nil
+}
+
+func (f SessionFilter) matcher() (func(*RealmSession) bool, error) {
+	var network *net.IPNet
+	if strings.Contains(f.IPAddress, "/") {
+		var err error
+		if _, network, err = net.ParseCIDR(f.IPAddress); err != nil {
+			return nil, errors.Wrap(err, "invalid session filter")
+		}
+	}
+
+	return func(item *RealmSession) bool {
+		session := item.Session
+		if f.Offline != nil && *f.Offline != item.Offline {
+			return false
+		}
+		if f.IPAddress != "" {
+			ip := PString(session.IPAddress)
+			if network != nil {
+				if parsed := net.ParseIP(ip); parsed == nil || !network.Contains(parsed) {
+					return false
+				}
+			} else if ip != f.IPAddress {
+				return false
+			}
+		}
+		start := time.UnixMilli(PInt64(session.Start))
+		if !f.StartedBefore.IsZero() && !start.Before(f.StartedBefore) {
+			return false
+		}
+		if !f.StartedAfter.IsZero() && !start.After(f.StartedAfter) {
+			return false
+		}
+		if len(f.UserIDs) > 0 && !containsString(f.UserIDs, PString(session.UserID)) {
+			return false
+		}
+		if len(f.ClientIDs) > 0 {
+			if session.Clients == nil {
+				return false
+			}
+			for _, clientID := range *session.Clients {
+				if containsString(f.ClientIDs, clientID) {
+					return true
+				}
+			}
+			return false
+		}
+		return true
+	}, nil
+}
+
+// LogoutSessions logs out the sessions of the realm selected by the filter and returns them.
+// Offline sessions are only logged out if the filter selects them explicitly.
+// On error, the sessions logged out so far are returned.
+func (g *GoKeycloak) LogoutSessions(ctx context.Context, token, realm string, filter SessionFilter) ([]*RealmSession, error) {
+	if _, err := filter.matcher(); err != nil {
+		return nil, err
+	}
+	if filter.Offline == nil {
+		filter.Offline = BoolP(false)
+	}
+
+	inventory, err := g.GetSessionInventory(ctx, token, realm, SessionInventoryOptions{
+		ClientIDs: filter.ClientIDs,
+		Offline:   *filter.Offline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := inventory.Filter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	loggedOut := []*RealmSession{}
+	for _, session := range sessions {
+		var status int
+		if session.Offline {
+			status, err = g.logoutOfflineSession(ctx, token, realm, PString(session.Session.ID))
+		} else {
+			status, err = g.LogoutUserSession(ctx, token, realm, PString(session.Session.ID))
+		}
+		// the session may have expired meanwhile
+		if err != nil && status != http.StatusNotFound {
+			return loggedOut, err
+		}
+		loggedOut = append(loggedOut, session)
+	}
+
+	return loggedOut, nil
+}
@@ -0,0 +1,129 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+// fakeSessions serves the sessions of the clients c1 (web) and c2 (api) of realm test
+func fakeSessions(t *testing.T) *fakeServer {
+	t.Helper()
+
+	sessions := map[string][]string{
+		"c1/user-sessions": {
+			`{"id": "s1", "userId": "u1", "ipAddress": "10.0.0.1", "start": 1700000000000, "clients": {"c1": "web", "c2": "api"}}`,
+			`{"id": "s2", "userId": "u2", "ipAddress": "192.168.1.5", "start": 1700000600000, "clients": {"c1": "web"}}`,
+			`{"id": "s3", "userId": "u1", "ipAddress": "10.0.0.2", "start": 1700001200000, "clients": {"c1": "web"}}`,
+		},
+		"c2/user-sessions": {
+			`{"id": "s1", "userId": "u1", "ipAddress": "10.0.0.1", "start": 1700000000000, "clients": {"c1": "web", "c2": "api"}}`,
+		},
+		"c2/offline-sessions": {
+			`{"id": "s4", "userId": "u2", "ipAddress": "10.0.0.3", "start": 1690000000000, "clients": {"c2": "api"}}`,
+		},
+	}
+
+	return newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch path := strings.TrimPrefix(r.URL.Path, "/admin/realms/test/"); {
+		case r.Method == http.MethodDelete && r.URL.Query().Get("isOffline") == "true":
+			// the offline session expired meanwhile
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		case path == "client-session-stats":
+			_, _ = w.Write([]byte(`[{"id": "c1", "clientId": "web", "active": "3", "offline": "0"},
+				{"id": "c2", "clientId": "api", "active": "1", "offline": "1"}]`))
+		case path == "logout-all" || path == "push-revocation":
+			_, _ = w.Write([]byte(`{"successRequests": ["https://web/admin"], "failedRequests": []}`))
+		case path == "clients/c1/session-count":
+			_, _ = w.Write([]byte(`{"count": 3}`))
+		case path == "clients/c2/offline-session-count":
+			_, _ = w.Write([]byte(`{"count": 1}`))
+		default:
+			page := sessions[strings.TrimPrefix(path, "clients/")]
+			first, end := pageBounds(r, len(page))
+			_, _ = w.Write([]byte("[" + strings.Join(page[first:end], ",") + "]"))
+		}
+	})
+}
+
+func Test_ClientSessionStats(t *testing.T) {
+	t.Parallel()
+
+	client := fakeSessions(t).client()
+	ctx := context.Background()
+
+	_, stats, err := client.GetClientSessionStats(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+	require.Equal(t, int64(3), *stats[0].Active)
+	require.Equal(t, int64(1), *stats[1].Offline)
+
+	_, count, err := client.GetClientSessionCount(ctx, "token", "test", "c1")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+	_, count, err = client.GetClientOfflineSessionCount(ctx, "token", "test", "c2")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	_, result, err := client.LogoutAllUsers(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://web/admin"}, *result.SuccessRequests)
+	_, _, err = client.PushRevocation(ctx, "token", "test")
+	require.NoError(t, err)
+}
+
+func Test_SessionInventory(t *testing.T) {
+	t.Parallel()
+
+	server := fakeSessions(t)
+
+	inventory, err := server.client().GetSessionInventory(context.Background(), "token", "test", gokeycloak.SessionInventoryOptions{Offline: true, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, inventory.Sessions, 4)
+	require.Len(t, inventory.ByClient["web"], 3)
+	require.Len(t, inventory.ByClient["api"], 2)
+	require.Len(t, inventory.ByUser["u1"], 2)
+	require.Len(t, inventory.ByUser["u2"], 2)
+	require.Contains(t, server.calls(), "GET /admin/realms/test/clients/c1/user-sessions?first=2&max=2")
+
+	selected, err := inventory.Filter(gokeycloak.SessionFilter{IPAddress: "10.0.0.0/8"})
+	require.NoError(t, err)
+	require.Len(t, selected, 3)
+	selected, err = inventory.Filter(gokeycloak.SessionFilter{StartedAfter: time.UnixMilli(1700000000000), ClientIDs: []string{"web"}})
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	_, err = inventory.Filter(gokeycloak.SessionFilter{IPAddress: "10.0.0.0/99"})
+	require.Error(t, err)
+}
+
+func Test_LogoutSessions(t *testing.T) {
+	t.Parallel()
+
+	server := fakeSessions(t)
+	client := server.client()
+	ctx := context.Background()
+
+	loggedOut, err := client.LogoutSessions(ctx, "token", "test", gokeycloak.SessionFilter{UserIDs: []string{"u2"}})
+	require.NoError(t, err)
+	require.Len(t, loggedOut, 1)
+	require.Contains(t, server.calls(), "DELETE /admin/realms/test/sessions/s2")
+
+	server.reset()
+	loggedOut, err = client.LogoutSessions(ctx, "token", "test", gokeycloak.SessionFilter{
+		Offline:       gokeycloak.BoolP(true),
+		StartedBefore: time.UnixMilli(1700000000000),
+	})
+	require.NoError(t, err)
+	require.Len(t, loggedOut, 1)
+	require.Equal(t, "s4", *loggedOut[0].Session.ID)
+	require.Contains(t, server.calls(), "DELETE /admin/realms/test/sessions/s4?isOffline=true")
+}
@@ -243,6 +243,72 @@ func (g *GoKeycloak) GetUserOfflineSessionsForClient(ctx context.Context, token,
 	return resp.StatusCode(), res, nil
 }
 
+// GetUserConsents returns the consents of the user and the clients holding offline tokens of the user
+func (g *GoKeycloak) GetUserConsents(ctx context.Context, token, realm, userID string) (int, []*UserConsentRepresentation, error) {
+	const errMessage = "could not get user consents"
+
+	var res []*UserConsentRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&res).
+		Get(g.getAdminRealmURL(realm, "users", userID, "consents"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), res, nil
+}
+
+// RevokeUserOfflineTokens revokes the offline tokens of the user for all clients and returns the clientIds of the clients.
+// Keycloak revokes offline tokens together with the consent, so the consents of the clients are revoked as well.
+// Offline sessions left over afterwards are logged out.
+func (g *GoKeycloak) RevokeUserOfflineTokens(ctx context.Context, token, realm, userID string) ([]string, error) {
+	_, consents, err := g.GetUserConsents(ctx, token, realm, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := []string{}
+	for _, consent := range consents {
+		if !consent.HasOfflineToken() {
+			continue
+		}
+		clientID := PString(consent.ClientID)
+		if _, err := g.RevokeUserConsents(ctx, token, realm, userID, clientID); err != nil {
+			return revoked, err
+		}
+		revoked = append(revoked, clientID)
+
+		_, clients, err := g.GetClients(ctx, token, realm, GetClientsParams{ClientID: StringP(clientID)})
+		if err != nil {
+			return revoked, err
+		}
+		for _, client := range clients {
+			_, sessions, err := g.GetUserOfflineSessionsForClient(ctx, token, realm, userID, PString(client.ID))
+			if err != nil {
+				return revoked, err
+			}
+			for _, session := range sessions {
+				if _, err := g.logoutOfflineSession(ctx, token, realm, PString(session.ID)); err != nil {
+					return revoked, err
+				}
+			}
+		}
+	}
+
+	return revoked, nil
+}
+
+func (g *GoKeycloak) logoutOfflineSession(ctx context.Context, token, realm, session string) (int, error) {
+	const errMessage = "could not logout offline session"
+
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetQueryParam("isOffline", "true").
+		Delete(g.getAdminRealmURL(realm, "sessions", session))
+
+	return resp.StatusCode(), checkForError(resp, err, errMessage)
+}
+
 // AddClientRolesToUser adds client-level role mappings
 func (g *GoKeycloak) AddClientRolesToUser(ctx context.Context, token, realm, idOfClient, userID string, roles []Role) (int, error) {
 	const errMessage = "could not add client role to user"
@@ -315,3 +381,31 @@ func (g *GoKeycloak) DeleteUserFederatedIdentity(ctx context.Context, token, rea
 
 	return resp.StatusCode(), checkForError(resp, err, errMessage)
 }
+
+// ImpersonateUser starts a session of the user for the caller, which needs the impersonation role of realm-management.
+// The redirect URL and the session cookies are returned, so they can be handed to a browser.
+// If the caller may not impersonate the user, the error is an APIError of type APIErrTypeImpersonationForbidden.
+func (g *GoKeycloak) ImpersonateUser(ctx context.Context, token, realm, userID string) (int, *ImpersonationRepresentation, error) {
+	const errMessage = "could not impersonate user"
+
+	var res ImpersonationRepresentation
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&res).
+		Post(g.getAdminRealmURL(realm, "users", userID, "impersonation"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, impersonationError(err)
+	}
+	res.Cookies = resp.Cookies()
+
+	return resp.StatusCode(), &res, nil
+}
+
+// impersonationError marks the permission failures of an impersonation
+func impersonationError(err error) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusForbidden {
+		apiErr.Type = APIErrTypeImpersonationForbidden
+	}
+	return err
+}
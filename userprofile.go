@@ -0,0 +1,274 @@
+package gokeycloak
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ------------
+// User Profile
+// ------------
+
+// GetUserProfile returns the declarative user profile of the realm (Keycloak 21+)
+func (g *GoKeycloak) GetUserProfile(ctx context.Context, token, realm string) (int, *UserProfileConfig, error) {
+	const errMessage = "could not get user profile"
+
+	var result UserProfileConfig
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "users", "profile"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// UpdateUserProfile replaces the declarative user profile of the realm and returns the stored profile
+func (g *GoKeycloak) UpdateUserProfile(ctx context.Context, token, realm string, profile UserProfileConfig) (int, *UserProfileConfig, error) {
+	const errMessage = "could not update user profile"
+
+	var result UserProfileConfig
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		SetBody(profile).
+		Put(g.getAdminRealmURL(realm, "users", "profile"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// GetUserProfileMetadata returns the user profile as resolved for the admin context
+func (g *GoKeycloak) GetUserProfileMetadata(ctx context.Context, token, realm string) (int, *UserProfileMetadata, error) {
+	const errMessage = "could not get user profile metadata"
+
+	var result UserProfileMetadata
+	resp, err := g.GetRequestWithBearerAuth(ctx, token).
+		SetResult(&result).
+		Get(g.getAdminRealmURL(realm, "users", "profile", "metadata"))
+
+	if err := checkForError(resp, err, errMessage); err != nil {
+		return resp.StatusCode(), nil, err
+	}
+
+	return resp.StatusCode(), &result, nil
+}
+
+// ValidateUser fetches the user profile of the realm and validates the user against it, see UserProfileConfig.ValidateUser
+func (g *GoKeycloak) ValidateUser(ctx context.Context, token, realm string, user User) error {
+	_, profile, err := g.GetUserProfile(ctx, token, realm)
+	if err != nil {
+		return err
+	}
+
+	return profile.ValidateUser(user)
+}
+
+// UserProfileAttributeError is a violation of the user profile by an attribute
+type UserProfileAttributeError struct {
+	Attribute string `json:"attribute"`
+	// Validator is the name of the failed validator, e.g. "length", or "required" and "multivalued"
+	// for attributes without a value or with several values
+	Validator string `json:"validator"`
+	Message   string `json:"message"`
+}
+
+// UserProfileValidationError is returned by ValidateUser, it lists all violations of the user profile
+type UserProfileValidationError struct {
+	Errors []UserProfileAttributeError `json:"errors"`
+}
+
+// Error returns the violations of the user profile
+func (e *UserProfileValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", err.Attribute, err.Message))
+	}
+	return "user violates user profile: " + strings.Join(messages, "; ")
+}
+
+// ValidateUser checks the user against the profile as an admin would create or update it, so required attributes
+// and validators fail fast with per attribute errors instead of a single error from Keycloak.
+// Only the built in validators length, email, pattern, options, integer, double and uri are checked,
+// other validators are left to Keycloak, as are patterns which Go can not compile, e.g. with lookaheads.
+// The error is a *UserProfileValidationError.
+func (c *UserProfileConfig) ValidateUser(user User) error {
+	if c.Attributes == nil {
+		return nil
+	}
+
+	var errs []UserProfileAttributeError
+	for _, attribute := range *c.Attributes {
+		name := PString(attribute.Name)
+		values := userAttributeValues(user, name)
+		fail := func(validator, message string) {
+			errs = append(errs, UserProfileAttributeError{Attribute: name, Validator: validator, Message: message})
+		}
+
+		if len(values) == 0 {
+			if attribute.requiredForAdmin() {
+				fail("required", "is required")
+			}
+			continue
+		}
+		if len(values) > 1 && !PBool(attribute.Multivalued) {
+			fail("multivalued", "must have a single value")
+		}
+		if attribute.Validations == nil {
+			continue
+		}
+
+		validators := make([]string, 0, len(*attribute.Validations))
+		for validator := range *attribute.Validations {
+			validators = append(validators, validator)
+		}
+		sort.Strings(validators)
+		for _, validator := range validators {
+			config := (*attribute.Validations)[validator]
+			for _, value := range values {
+				if message := validateUserProfileValue(validator, config, value); message != "" {
+					fail(validator, message)
+					break
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return &UserProfileValidationError{Errors: errs}
+	}
+
+	return nil
+}
+
+// requiredForAdmin reports whether the attribute is required when an admin manages the user.
+// Attributes only required for some scopes are not, as no scope is requested.
+func (a UserProfileAttribute) requiredForAdmin() bool {
+	if a.Required == nil || !NilOrEmptySlice(a.Required.Scopes) {
+		return false
+	}
+	return NilOrEmptySlice(a.Required.Roles) || containsString(*a.Required.Roles, "admin")
+}
+
+// userAttributeValues returns the non-empty values of an attribute, including the attributes stored as fields of User
+func userAttributeValues(user User, name string) []string {
+	var values []string
+	switch name {
+	case "username":
+		values = []string{PString(user.Username)}
+	case "email":
+		values = []string{PString(user.Email)}
+	case "firstName":
+		values = []string{PString(user.FirstName)}
+	case "lastName":
+		values = []string{PString(user.LastName)}
+	default:
+		if user.Attributes != nil {
+			values = (*user.Attributes)[name]
+		}
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// validateUserProfileValue applies a built in validator of Keycloak and returns an error message if the value is invalid
+func validateUserProfileValue(validator string, config map[string]interface{}, value string) string {
+	if message, ok := config["error-message"].(string); ok {
+		if validateUserProfileValue(validator, withoutKey(config, "error-message"), value) == "" {
+			return ""
+		}
+		return message
+	}
+
+	switch validator {
+	case "length":
+		if trim, _ := config["trim-disabled"].(bool); !trim {
+			value = strings.TrimSpace(value)
+		}
+		length := float64(utf8.RuneCountInString(value))
+		if min, ok := configNumber(config, "min"); ok && length < min {
+			return fmt.Sprintf("must have at least %v characters", min)
+		}
+		if max, ok := configNumber(config, "max"); ok && length > max {
+			return fmt.Sprintf("must have at most %v characters", max)
+		}
+	case "email":
+		if address, err := mail.ParseAddress(value); err != nil || address.Address != value {
+			return "must be a valid email address"
+		}
+	case "pattern":
+		// Keycloak requires the whole value to match the pattern. It uses Java patterns, those RE2 does not
+		// support, e.g. lookaheads or possessive quantifiers, are left to Keycloak.
+		pattern, _ := config["pattern"].(string)
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return ""
+		}
+		if !re.MatchString(value) {
+			return fmt.Sprintf("must match %s", pattern)
+		}
+	case "options":
+		options, _ := config["options"].([]interface{})
+		for _, option := range options {
+			if fmt.Sprint(option) == value {
+				return ""
+			}
+		}
+		return "must be one of the options"
+	case "integer", "double":
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || (validator == "integer" && number != float64(int64(number))) {
+			return fmt.Sprintf("must be a valid %s", validator)
+		}
+		if min, ok := configNumber(config, "min"); ok && number < min {
+			return fmt.Sprintf("must be at least %v", min)
+		}
+		if max, ok := configNumber(config, "max"); ok && number > max {
+			return fmt.Sprintf("must be at most %v", max)
+		}
+	case "uri":
+		if u, err := url.Parse(value); err != nil || u.Scheme == "" {
+			return "must be a valid URI"
+		}
+	}
+
+	return ""
+}
+
+func withoutKey(config map[string]interface{}, key string) map[string]interface{} {
+	result := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		if k != key {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// configNumber returns a number of a validator config, Keycloak accepts numbers and strings
+func configNumber(config map[string]interface{}, key string) (float64, bool) {
+	switch value := config[key].(type) {
+	case float64:
+		return value, true
+	case string:
+		number, err := strconv.ParseFloat(value, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
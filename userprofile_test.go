@@ -0,0 +1,130 @@
+package gokeycloak_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/zblocks/gokeycloak"
+)
+
+const testUserProfile = `{
+	"attributes": [
+		{"name": "username", "validations": {"length": {"min": 3, "max": 255}}},
+		{"name": "email", "required": {"roles": ["user"]}, "validations": {"email": {}}},
+		{"name": "firstName", "required": {"roles": ["admin", "user"]}},
+		{"name": "lastName", "required": {"scopes": ["profile"]}},
+		{"name": "department", "validations": {"options": {"options": ["sales", "support"]}}},
+		{"name": "age", "validations": {"integer": {"min": "18"}}},
+		{"name": "phone", "validations": {"pattern": {"pattern": "^\\+[0-9]+$", "error-message": "invalid phone number"}}},
+		{"name": "website", "multivalued": true, "validations": {"uri": {}}}
+	],
+	"groups": [{"name": "user-metadata", "displayHeader": "User metadata"}],
+	"unmanagedAttributePolicy": "ADMIN_VIEW"
+}`
+
+func fakeUserProfile(t *testing.T) *fakeServer {
+	t.Helper()
+
+	return newFakeServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /admin/realms/test/users/profile":
+			_, _ = w.Write([]byte(testUserProfile))
+		case "PUT /admin/realms/test/users/profile":
+			_, _ = io.Copy(w, r.Body)
+		case "GET /admin/realms/test/users/profile/metadata":
+			_, _ = w.Write([]byte(`{"attributes": [{"name": "username", "required": true, "readOnly": true, "multivalued": false}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+}
+
+func Test_UserProfile(t *testing.T) {
+	t.Parallel()
+
+	server := fakeUserProfile(t)
+	client := server.client()
+	ctx := context.Background()
+
+	_, profile, err := client.GetUserProfile(ctx, "token", "test")
+	require.NoError(t, err)
+	require.Len(t, *profile.Attributes, 8)
+	require.Equal(t, *gokeycloak.UnmanagedAttributesAdminView, *profile.UnmanagedAttributePolicy)
+
+	_, stored, err := client.UpdateUserProfile(ctx, "token", "test", *profile)
+	require.NoError(t, err)
+	require.Equal(t, profile, stored)
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(server.lastRequest(t, http.MethodPut, "/admin/realms/test/users/profile").Body, &body))
+	require.Equal(t, "ADMIN_VIEW", body["unmanagedAttributePolicy"])
+
+	_, metadata, err := client.GetUserProfileMetadata(ctx, "token", "test")
+	require.NoError(t, err)
+	require.True(t, gokeycloak.PBool((*metadata.Attributes)[0].ReadOnly))
+}
+
+func Test_ValidateUser(t *testing.T) {
+	t.Parallel()
+
+	client := fakeUserProfile(t).client()
+	ctx := context.Background()
+
+	require.NoError(t, client.ValidateUser(ctx, "token", "test", gokeycloak.User{
+		Username:  gokeycloak.StringP("jdoe"),
+		FirstName: gokeycloak.StringP("John"),
+		Attributes: &map[string][]string{
+			"department": {"sales"},
+			"age":        {"42"},
+			"phone":      {"+4912345"},
+			"website":    {"https://example.com", "https://example.org"},
+		},
+	}))
+
+	err := client.ValidateUser(ctx, "token", "test", gokeycloak.User{
+		Username: gokeycloak.StringP("jd"),
+		Email:    gokeycloak.StringP("not an email"),
+		Attributes: &map[string][]string{
+			"department": {"marketing", "sales"},
+			"age":        {"17.5"},
+			"phone":      {"12345"},
+			"website":    {"example.com"},
+		},
+	})
+	var validationErr *gokeycloak.UserProfileValidationError
+	require.True(t, errors.As(err, &validationErr))
+	require.Equal(t, []gokeycloak.UserProfileAttributeError{
+		{Attribute: "username", Validator: "length", Message: "must have at least 3 characters"},
+		{Attribute: "email", Validator: "email", Message: "must be a valid email address"},
+		{Attribute: "firstName", Validator: "required", Message: "is required"},
+		{Attribute: "department", Validator: "multivalued", Message: "must have a single value"},
+		{Attribute: "department", Validator: "options", Message: "must be one of the options"},
+		{Attribute: "age", Validator: "integer", Message: "must be a valid integer"},
+		{Attribute: "phone", Validator: "pattern", Message: "invalid phone number"},
+		{Attribute: "website", Validator: "uri", Message: "must be a valid URI"},
+	}, validationErr.Errors)
+}
+
+func TestUserProfileConfig_ValidateUser(t *testing.T) {
+	t.Parallel()
+
+	var validationErr *gokeycloak.UserProfileValidationError
+	profile := &gokeycloak.UserProfileConfig{Attributes: &[]gokeycloak.UserProfileAttribute{{
+		Name:        gokeycloak.StringP("code"),
+		Validations: &map[string]map[string]interface{}{"pattern": {"pattern": "[a-z]+"}},
+	}}}
+	err := profile.ValidateUser(gokeycloak.User{Attributes: &map[string][]string{"code": {"abc123"}}})
+	require.True(t, errors.As(err, &validationErr), "the whole value must match")
+	require.Equal(t, "must match [a-z]+", validationErr.Errors[0].Message)
+	require.NoError(t, profile.ValidateUser(gokeycloak.User{Attributes: &map[string][]string{"code": {"abc"}}}))
+
+	// Java patterns which Go can not compile are left to Keycloak
+	(*profile.Attributes)[0].Validations = &map[string]map[string]interface{}{"pattern": {"pattern": "(?=.*[0-9])[a-z0-9]+", "error-message": "invalid code"}}
+	require.NoError(t, profile.ValidateUser(gokeycloak.User{Attributes: &map[string][]string{"code": {"abc"}}}))
+}
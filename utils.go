@@ -135,6 +135,26 @@ func PolicyEnforcementModeP(value PolicyEnforcementMode) *PolicyEnforcementMode
 	return &value
 }
 
+// PartialImportPolicyP returns a pointer for a PartialImportPolicy value
+func PartialImportPolicyP(value PartialImportPolicy) *PartialImportPolicy {
+	return &value
+}
+
+// PartialImportActionP returns a pointer for a PartialImportAction value
+func PartialImportActionP(value PartialImportAction) *PartialImportAction {
+	return &value
+}
+
+// UnmanagedAttributePolicyP returns a pointer for an UnmanagedAttributePolicy value
+func UnmanagedAttributePolicyP(value UnmanagedAttributePolicy) *UnmanagedAttributePolicy {
+	return &value
+}
+
+// KeyStoreFormatP returns a pointer for a KeyStoreFormat value
+func KeyStoreFormatP(value KeyStoreFormat) *KeyStoreFormat {
+	return &value
+}
+
 // PStringSlice converts a pointer to []string or returns ampty slice if nill value
 func PStringSlice(value *[]string) []string {
 	if value == nil {
@@ -154,11 +174,18 @@ func WithTracer(ctx context.Context, tracer opentracing.Tracer) context.Context
 }
 
 func checkForError(resp *resty.Response, err error, errMessage string) error {
+	recordResponse(resp)
+
+	return responseError(resp, requestError(resp, err), errMessage)
+}
+
+func responseError(resp *resty.Response, err error, errMessage string) error {
 	if err != nil {
 		return &APIError{
 			Code:    0,
 			Message: errors.Wrap(err, errMessage).Error(),
 			Type:    ParseAPIErrType(err),
+			cause:   err,
 		}
 	}
 